@@ -1,57 +1,250 @@
 package main
 
 import (
+	"flag"
 	"log"
-	"math/rand"
-	"time"
+	"slices"
+	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
 
+	"snake-game/internal/assets"
 	"snake-game/internal/game" // Reference game constants
+	"snake-game/internal/render"
 	"snake-game/internal/scene"
-	"snake-game/internal/scene/gameover" // Import gameover scene
-	"snake-game/internal/scene/gameplay" // Import gameplay scene
-
-	// Import other scenes (MainMenu, Pause, etc.) when created
-	"snake-game/internal/render" // Import render package
+	"snake-game/internal/scene/gameover"   // Import gameover scene
+	"snake-game/internal/scene/gameplay"   // Import gameplay scene
+	"snake-game/internal/scene/mainmenu"   // Import main menu scene
+	"snake-game/internal/scene/options"    // Import options scene
+	"snake-game/internal/scene/pause"      // Import pause scene
+	"snake-game/internal/scene/statistics" // Import stats scene
+	"snake-game/internal/settings"
 )
 
+// Accepted range for -width and -height, in cells. Below the minimum the
+// starting snakes and survival zone don't fit.
 const (
-	// Keep screen dimensions consistent for now
-	// We can make this more dynamic later if needed
-	screenWidth  = game.GridWidth * render.GridCellSize  // Use render constant
-	screenHeight = game.GridHeight * render.GridCellSize // Use render constant
+	minBoardSide = 20
+	maxBoardSide = 100
 )
 
 func main() {
-	// Seed random number generator once at the start
-	rand.Seed(time.Now().UnixNano())
+	levelPath := flag.String("level", "", "path to a level map (.txt ASCII or .json)")
+	seed := flag.Int64("seed", 0, "random seed for a reproducible first round (0 = random)")
+	replayPath := flag.String("replay", "", "play back a recorded round (e.g. replay.json from the config directory)")
+	debugPaths := flag.Bool("debug-paths", false, "draw the path each enemy is following and its next move")
+	debugKeys := flag.Bool("debug-keys", false, "enable debug hotkeys during play ([ and ] slow down and speed up time, \\ resets it)")
+	holdToTurn := flag.Bool("hold-to-turn", false, "keep turning towards a held direction key, not only when it is pressed")
+	rounded := flag.Bool("rounded", true, "draw snakes and food that have no sprite as smooth rounded shapes; -rounded=false keeps them square")
+	foodFPS := flag.Float64("food-fps", assets.FoodFrameRate, "frames per second of animated food sprites (sprite sheets such as food1_sheet.png)")
+	eatFlash := flag.Float64("eat-flash", render.FlashDuration, "seconds the ring flashing out from eaten food lasts (0 turns it off)")
+	fullscreen := flag.Bool("fullscreen", true, "start fullscreen; -fullscreen=false opens a resizable window")
+	difficulty := flag.String("difficulty", "", "easy, normal or hard (default: the saved setting)")
+	mode := flag.String("mode", "", "game mode: classic, survival, practice, time-attack or wrap (classic with every edge wrapping)")
+	width := flag.Int("width", 0, "board width in cells (default: the medium board)")
+	height := flag.Int("height", 0, "board height in cells (default: the medium board)")
+	reachableFood := flag.Bool("reachable-food", false, "only spawn food where a player can get to it (a flood fill per spawn)")
+	startLength := flag.Int("start-length", 0, "segments the player snakes start with, 3 to 8 (default 3)")
+	start := flag.String("start", "", "where player 1 starts: left or center (default left)")
+	enemyDistance := flag.Int("enemy-distance", game.DefaultConfig().EnemySpawnDistance, "closest (in cells) a new enemy may appear to a player's head; 0 lets them appear anywhere")
+	wrap := flag.String("wrap", "", "board edges that wrap around instead of being walls: any of top,bottom,left,right, or all")
+	flag.Parse()
+
+	opts, err := settings.Load()
+	if err != nil {
+		log.Printf("Warning: Failed to load settings, using defaults: %v", err)
+	}
+	render.ShowGrid = opts.ShowGrid
+	render.ShowMinimap = opts.ShowMinimap
+	render.ShowBestRun = opts.ShowBestRun
+	render.HighlightFood = opts.HighlightFood
+	render.ShowIntent = opts.ShowIntent
+	render.ShowDangerGlow = opts.ShowDangerGlow
+	render.ActiveTheme = opts.Theme
+	if slices.Contains(render.CellSizes, opts.CellSize) {
+		render.View.CellSize = opts.CellSize // Otherwise unset (or edited by hand): keep the default
+	}
+	render.ShowPaths = *debugPaths
+	render.RoundedShapes = *rounded
+	render.FlashDuration = *eatFlash
+	assets.FoodFrameRate = *foodFPS
+	gameplay.DebugKeys = *debugKeys
+	gameplay.HoldToTurn = *holdToTurn
+
+	gameCfg := game.DefaultConfig()
+	gameCfg.Seed = *seed
+	gameCfg.ReachableFood = *reachableFood
+	if *enemyDistance >= 0 {
+		gameCfg.EnemySpawnDistance = *enemyDistance
+	} else {
+		log.Printf("Warning: Enemy distance %d is negative, using %d", *enemyDistance, gameCfg.EnemySpawnDistance)
+	}
+	gameCfg.Difficulty = game.DifficultyPreset(opts.Difficulty)
+	if *difficulty != "" {
+		if level, ok := parseDifficulty(*difficulty); ok {
+			gameCfg.Difficulty = game.DifficultyPreset(level)
+		} else {
+			log.Printf("Warning: Unknown difficulty %q, using %s", *difficulty, gameCfg.Difficulty.Level)
+		}
+	}
+	if *mode != "" {
+		if m, walls, ok := parseMode(*mode); ok {
+			gameCfg.Mode = m
+			gameCfg.Walls = walls
+		} else {
+			log.Printf("Warning: Unknown mode %q, using %s", *mode, gameCfg.Mode)
+		}
+	}
+	gameCfg.GridWidth = boardSide("width", *width, gameCfg.GridWidth)
+	gameCfg.GridHeight = boardSide("height", *height, gameCfg.GridHeight)
+	if *startLength != 0 {
+		if *startLength < game.MinStartLength || *startLength > game.MaxStartLength {
+			log.Printf("Warning: Start length %d is outside %d-%d, using %d", *startLength, game.MinStartLength, game.MaxStartLength, game.InitialSnakeLen)
+		} else {
+			gameCfg.StartLength = *startLength
+		}
+	}
+	if *start != "" {
+		if pos, ok := parseStart(*start); ok {
+			gameCfg.StartPosition = pos
+		} else {
+			log.Printf("Warning: Unknown start %q, using %s", *start, gameCfg.StartPosition)
+		}
+	}
+	if *wrap != "" {
+		if walls, ok := parseWrap(*wrap); ok {
+			gameCfg.Walls = walls
+		} else {
+			log.Printf("Warning: Unknown -wrap %q, walling every edge", *wrap)
+		}
+	}
+	if *levelPath != "" {
+		lvl, err := game.LoadLevelFile(*levelPath)
+		if err != nil {
+			log.Fatalf("Failed to load level: %v", err)
+		}
+		if err := lvl.Validate(gameCfg.StartLength); err != nil {
+			log.Fatalf("Failed to load level: %v", err)
+		}
+		gameCfg.Level = lvl
+	}
 
 	// Create the scene manager
-	manager := scene.NewManager(screenWidth, screenHeight)
+	manager := scene.NewManager(gameCfg)
+	manager.GetAudio().SetEnabled(opts.SoundEnabled)
 
 	// --- Register Scenes ---
-	// Register Gameplay Scene
-	manager.RegisterScene(scene.SceneTypeGameplay, func() scene.Scene { return gameplay.NewGameplayScene() })
-	// Register MainMenu Scene (when created)
-	// manager.RegisterScene(scene.SceneTypeMainMenu, func() scene.Scene { return mainmenu.NewMainMenuScene() })
+	// Register Gameplay Scene (or the replay viewer in its place)
+	initialScene := scene.SceneTypeMainMenu
+	if *replayPath != "" {
+		replay, err := game.LoadReplayFile(*replayPath)
+		if err != nil {
+			log.Fatalf("Failed to load replay: %v", err)
+		}
+		manager.RegisterScene(scene.SceneTypeGameplay, func() scene.Scene { return gameplay.NewReplayScene(replay) })
+		initialScene = scene.SceneTypeGameplay
+	} else {
+		manager.RegisterScene(scene.SceneTypeGameplay, func() scene.Scene { return gameplay.NewGameplayScene() })
+	}
+	// Register MainMenu Scene
+	manager.RegisterScene(scene.SceneTypeMainMenu, func() scene.Scene { return mainmenu.NewMainMenuScene() })
 	// Register GameOver Scene
 	manager.RegisterScene(scene.SceneTypeGameOver, func() scene.Scene { return gameover.NewGameOverScene() })
-	// Register Pause Scene (when created)
-	// manager.RegisterScene(scene.SceneTypePause, func() scene.Scene { return pause.NewPauseScene() })
+	// Register Pause Scene
+	manager.RegisterScene(scene.SceneTypePause, func() scene.Scene { return pause.NewPauseScene() })
+	// Register Options Scene
+	manager.RegisterScene(scene.SceneTypeOptions, func() scene.Scene { return options.NewOptionsScene() })
+	// Register Stats Scene
+	manager.RegisterScene(scene.SceneTypeStats, func() scene.Scene { return statistics.NewStatsScene() })
 
 	// --- Set Initial Scene ---
-	manager.SetInitialScene(scene.SceneTypeGameplay) // Start with Gameplay for now
-	// manager.SetInitialScene(scene.SceneTypeMainMenu) // Change this to MainMenu later
+	manager.SetInitialScene(initialScene)
 
-	// Configure Ebitengine window
-	ebiten.SetWindowSize(screenWidth, screenHeight)
+	// Configure Ebitengine window (sized from the chosen board)
+	ebiten.SetWindowSize(manager.GetWindowSize())
 	ebiten.SetWindowTitle("Super Snake GO")
-	// ebiten.SetFullscreen(true) // Disable fullscreen for now during development
-	ebiten.SetFullscreen(true) // Re-enable fullscreen
+	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled) // Draw letterboxes the board to any size
+	ebiten.SetFullscreen(*fullscreen)
+	ebiten.SetRunnableOnUnfocused(true) // Keep updating so gameplay can notice focus loss and pause
 
 	// Run the game using the SceneManager as the ebiten.Game implementation
 	if err := ebiten.RunGame(manager); err != nil {
 		log.Fatalf("Ebitengine RunGame error: %v", err)
 	}
 }
+
+// parseDifficulty looks up a difficulty level by its display name, ignoring case.
+func parseDifficulty(name string) (game.DifficultyLevel, bool) {
+	for level := game.DifficultyLevel(0); level < game.NumDifficultyLevels; level++ {
+		if strings.EqualFold(level.String(), name) {
+			return level, true
+		}
+	}
+	return 0, false
+}
+
+// parseMode looks up a game mode by its display name, ignoring case and
+// with a hyphen standing in for a space ("time-attack"), along with the
+// board edges that wrap. "wrap" is a classic round with every edge wrapping;
+// the other modes wall every edge.
+func parseMode(name string) (game.GameMode, game.WallConfig, bool) {
+	if strings.EqualFold(name, "wrap") {
+		return game.ModeClassic, wrapAll, true
+	}
+	name = strings.ReplaceAll(name, "-", " ")
+	for m := game.GameMode(0); m < game.NumGameModes; m++ {
+		if strings.EqualFold(m.String(), name) {
+			return m, game.WallConfig{}, true
+		}
+	}
+	return 0, game.WallConfig{}, false
+}
+
+// parseStart looks up a start position by its display name, ignoring case.
+func parseStart(name string) (game.StartPosition, bool) {
+	for pos := game.StartPosition(0); pos < game.NumStartPositions; pos++ {
+		if strings.EqualFold(pos.String(), name) {
+			return pos, true
+		}
+	}
+	return 0, false
+}
+
+// wrapAll wraps every board edge, for -wrap all and -mode=wrap.
+var wrapAll = game.WallConfig{WrapTop: true, WrapBottom: true, WrapLeft: true, WrapRight: true}
+
+// parseWrap reads a comma-separated list of the edges that wrap (top,
+// bottom, left, right), or "all" for every edge.
+func parseWrap(list string) (game.WallConfig, bool) {
+	var walls game.WallConfig
+	for _, edge := range strings.Split(list, ",") {
+		switch strings.ToLower(strings.TrimSpace(edge)) {
+		case "top":
+			walls.WrapTop = true
+		case "bottom":
+			walls.WrapBottom = true
+		case "left":
+			walls.WrapLeft = true
+		case "right":
+			walls.WrapRight = true
+		case "all":
+			walls = wrapAll
+		default:
+			return game.WallConfig{}, false
+		}
+	}
+	return walls, true
+}
+
+// boardSide validates a -width or -height value, keeping def when it is unset
+// or out of range.
+func boardSide(name string, value, def int) int {
+	if value == 0 {
+		return def
+	}
+	if value < minBoardSide || value > maxBoardSide {
+		log.Printf("Warning: Board %s %d is outside %d-%d, using %d", name, value, minBoardSide, maxBoardSide, def)
+		return def
+	}
+	return value
+}
@@ -0,0 +1,69 @@
+package assets
+
+import (
+	"fmt"
+	"image"
+	"io/fs"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// FoodFrameRate is the frame rate (frames per second) food animations are
+// loaded with. Set it before creating the Manager.
+var FoodFrameRate = 8.0
+
+// Animation is a sprite that changes over time: Frames are shown in turn,
+// each for FrameDuration seconds, looping. A static sprite is a one-frame
+// animation.
+type Animation struct {
+	Frames        []*ebiten.Image
+	FrameDuration float64 // Seconds each frame is shown
+}
+
+// NewAnimation returns a one-frame animation showing img.
+func NewAnimation(img *ebiten.Image) *Animation {
+	return &Animation{Frames: []*ebiten.Image{img}}
+}
+
+// Frame returns the frame shown t seconds into the animation.
+func (a *Animation) Frame(t float64) *ebiten.Image {
+	if len(a.Frames) == 1 || a.FrameDuration <= 0 || t < 0 {
+		return a.Frames[0]
+	}
+	return a.Frames[int(t/a.FrameDuration)%len(a.Frames)]
+}
+
+// sliceSheet cuts a sprite sheet into an animation. The frames are square,
+// as tall as the sheet, and laid out left to right.
+func sliceSheet(sheet *ebiten.Image, frameRate float64) (*Animation, error) {
+	w, h := sheet.Bounds().Dx(), sheet.Bounds().Dy()
+	if h == 0 || w%h != 0 {
+		return nil, fmt.Errorf("sprite sheet is %dx%d, not a row of square frames", w, h)
+	}
+	anim := &Animation{}
+	if frameRate > 0 {
+		anim.FrameDuration = 1 / frameRate
+	}
+	for x := 0; x < w; x += h {
+		anim.Frames = append(anim.Frames, sheet.SubImage(image.Rect(x, 0, x+h, h)).(*ebiten.Image))
+	}
+	return anim, nil
+}
+
+// loadFood loads the food sprite called name: the sprite sheet
+// <name>_sheet.png if there is a usable one, otherwise the still <name>.png.
+func loadFood(fsys fs.FS, name string) (*Animation, error) {
+	if sheet, err := loadImage(fsys, name+"_sheet.png"); err == nil {
+		anim, err := sliceSheet(sheet, FoodFrameRate)
+		if err == nil {
+			return anim, nil
+		}
+		log.Printf("Warning: Failed to slice %s_sheet.png: %v", name, err)
+	}
+	img, err := loadImage(fsys, name+".png")
+	if err != nil {
+		return nil, err
+	}
+	return NewAnimation(img), nil
+}
@@ -2,8 +2,9 @@ package assets
 
 import (
 	"fmt"
+	"io/fs"
 	"log"
-	"path/filepath"
+	"os"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -17,66 +18,130 @@ const (
 // Manager handles loading and storing assets.
 type Manager struct {
 	// Images
-	SnakeHead    *ebiten.Image
-	SnakeBody    *ebiten.Image
-	FoodStandard *ebiten.Image
-	FoodSpeedUp  *ebiten.Image
-	FoodSlowDown *ebiten.Image
+	SnakeHead   *ebiten.Image
+	SnakeBody   *ebiten.Image
+	SnakeCorner *ebiten.Image // Bend joining a segment on the left with one below; optional
+	SnakeTail   *ebiten.Image // Last segment, joining the body on its right; optional
+	// Food sprites are still images, or animations where a sprite sheet
+	// (e.g. food1_sheet.png next to food1.png) is found
+	FoodStandard *Animation
+	FoodSpeedUp  *Animation
+	FoodSlowDown *Animation
+	FoodTeleport *Animation
+	FoodShrink   *Animation
+	FoodShield   *Animation
+	FoodBomb     *Animation
+	FoodGolden   *Animation
+	FoodGhost    *Animation
 	Background   *ebiten.Image
 	Wall         *ebiten.Image
 
-	// Add maps for sounds later
+	// Sounds live in the audio package
 }
 
-// NewManager creates and loads assets.
-func NewManager() (*Manager, error) {
+// NewManager creates and loads assets from the images directory on disk.
+func NewManager() *Manager {
+	return NewManagerFromFS(os.DirFS(imgDir))
+}
+
+// NewManagerFromFS creates and loads assets from the given file system.
+// Image names are looked up at the root of fsys. This lets callers such as
+// headless render tools supply their own image set without depending on the
+// working directory. Missing images are never fatal: the snake and the
+// basic food are replaced by generated placeholders, and the other images
+// are left nil for the renderer to draw without.
+func NewManagerFromFS(fsys fs.FS) *Manager {
 	m := &Manager{}
 	var err error
 
 	// Load Images
-	m.SnakeHead, err = loadImage("head.png")
+	m.SnakeHead, err = loadImage(fsys, "head.png")
 	if err != nil {
-		return nil, fmt.Errorf("failed to load head image: %w", err)
+		log.Printf("Warning: Failed to load head image: %v", err)
+		m.SnakeHead = placeholderHead() // Generated stand-in
 	}
-	m.SnakeBody, err = loadImage("body.png")
+	m.SnakeBody, err = loadImage(fsys, "body.png")
 	if err != nil {
-		return nil, fmt.Errorf("failed to load body image: %w", err)
+		log.Printf("Warning: Failed to load body image: %v", err)
+		m.SnakeBody = placeholderBody() // Generated stand-in
 	}
-	m.FoodStandard, err = loadImage("food1.png") // Example mapping
+	m.SnakeCorner, err = loadImage(fsys, "body_corner.png")
 	if err != nil {
-		return nil, fmt.Errorf("failed to load food1 image: %w", err)
+		log.Printf("Warning: Failed to load body corner image: %v", err)
+		m.SnakeCorner = nil // Turns use the straight body sprite instead
 	}
-	m.FoodSpeedUp, err = loadImage("food2.png") // Example mapping
+	m.SnakeTail, err = loadImage(fsys, "tail.png")
 	if err != nil {
-		return nil, fmt.Errorf("failed to load food2 image: %w", err)
+		log.Printf("Warning: Failed to load tail image: %v", err)
+		m.SnakeTail = nil // The last segment uses the body sprite instead
 	}
-	m.FoodSlowDown, err = loadImage("food3.png") // Example mapping
+	m.FoodStandard, err = loadFood(fsys, "food1") // Example mapping
 	if err != nil {
-		return nil, fmt.Errorf("failed to load food3 image: %w", err)
+		log.Printf("Warning: Failed to load food1 image: %v", err)
+		m.FoodStandard = NewAnimation(placeholderDisc(placeholderFood)) // Generated stand-in
+	}
+	m.FoodSpeedUp, err = loadFood(fsys, "food2") // Example mapping
+	if err != nil {
+		log.Printf("Warning: Failed to load food2 image: %v", err)
+		m.FoodSpeedUp = NewAnimation(placeholderDisc(placeholderSpeedUp)) // Generated stand-in
+	}
+	m.FoodSlowDown, err = loadFood(fsys, "food3") // Example mapping
+	if err != nil {
+		log.Printf("Warning: Failed to load food3 image: %v", err)
+		m.FoodSlowDown = NewAnimation(placeholderDisc(placeholderSlowDown)) // Generated stand-in
 	}
 
 	// Load optional assets (handle potential errors gracefully)
-	m.Background, err = loadImage("background.png")
+	m.Background, err = loadImage(fsys, "background.png")
 	if err != nil {
 		log.Printf("Warning: Failed to load background image: %v", err)
 		m.Background = nil // Allow game to run without it
 	}
-	m.Wall, err = loadImage("wall.png")
+	m.FoodTeleport, err = loadFood(fsys, "food_teleport")
+	if err != nil {
+		log.Printf("Warning: Failed to load teleport food image: %v", err)
+		m.FoodTeleport = nil // Drawn as a plain circle instead
+	}
+	m.FoodShrink, err = loadFood(fsys, "food_shrink")
+	if err != nil {
+		log.Printf("Warning: Failed to load shrink food image: %v", err)
+		m.FoodShrink = nil // Drawn as a plain circle instead
+	}
+	m.FoodShield, err = loadFood(fsys, "food_shield")
+	if err != nil {
+		log.Printf("Warning: Failed to load shield food image: %v", err)
+		m.FoodShield = nil // Drawn as a plain circle instead
+	}
+	m.FoodBomb, err = loadFood(fsys, "food_bomb")
+	if err != nil {
+		log.Printf("Warning: Failed to load bomb image: %v", err)
+		m.FoodBomb = nil // Drawn as a plain circle instead
+	}
+	m.FoodGolden, err = loadFood(fsys, "food_golden")
+	if err != nil {
+		log.Printf("Warning: Failed to load golden apple image: %v", err)
+		m.FoodGolden = nil // Drawn as a plain circle instead
+	}
+	m.FoodGhost, err = loadFood(fsys, "food_ghost")
+	if err != nil {
+		log.Printf("Warning: Failed to load ghost food image: %v", err)
+		m.FoodGhost = nil // Drawn as a plain circle instead
+	}
+	m.Wall, err = loadImage(fsys, "wall.png")
 	if err != nil {
 		log.Printf("Warning: Failed to load wall image: %v", err)
 		m.Wall = nil // Use default drawing if wall sprite fails
 	}
 
 	log.Println("Assets loaded successfully.")
-	return m, nil
+	return m
 }
 
-// loadImage is a helper to load an image from the assets directory.
-func loadImage(name string) (*ebiten.Image, error) {
-	path := filepath.Join(imgDir, name)
-	img, _, err := ebitenutil.NewImageFromFile(path)
+// loadImage is a helper to load an image from the given file system.
+func loadImage(fsys fs.FS, name string) (*ebiten.Image, error) {
+	img, _, err := ebitenutil.NewImageFromFileSystem(fsys, name)
 	if err != nil {
-		return nil, fmt.Errorf("loading %s: %w", path, err)
+		return nil, fmt.Errorf("loading %s: %w", name, err)
 	}
 	return img, nil
 }
@@ -0,0 +1,72 @@
+package assets
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// placeholderSize is the width and height (px) of the generated sprites,
+// the same as the real ones.
+const placeholderSize = 20
+
+// Colors of the generated sprites. The snake is green like the real
+// sprites, so the renderer's hue shifts and tints still tell snakes apart;
+// the food matches the classic theme's colors for its type.
+var (
+	placeholderSnake    = color.RGBA{R: 40, G: 200, B: 70, A: 255}
+	placeholderOutline  = color.RGBA{R: 20, G: 110, B: 40, A: 255}
+	placeholderEye      = color.RGBA{R: 10, G: 20, B: 10, A: 255}
+	placeholderFood     = color.RGBA{R: 255, G: 0, B: 0, A: 255}
+	placeholderSpeedUp  = color.RGBA{R: 255, G: 165, B: 0, A: 255}
+	placeholderSlowDown = color.RGBA{R: 0, G: 191, B: 255, A: 255}
+)
+
+// placeholderBody returns a stand-in for body.png: a square with a darker
+// outline.
+func placeholderBody() *ebiten.Image {
+	return placeholder(func(x, y int) color.Color {
+		if x < 2 || y < 2 || x >= placeholderSize-2 || y >= placeholderSize-2 {
+			return placeholderOutline
+		}
+		return placeholderSnake
+	})
+}
+
+// placeholderHead returns a stand-in for head.png: the body square with
+// two eyes, facing right like the real sprite.
+func placeholderHead() *ebiten.Image {
+	return placeholder(func(x, y int) color.Color {
+		switch {
+		case x < 2 || y < 2 || x >= placeholderSize-2 || y >= placeholderSize-2:
+			return placeholderOutline
+		case x >= 12 && x < 15 && ((y >= 5 && y < 8) || (y >= 12 && y < 15)):
+			return placeholderEye
+		}
+		return placeholderSnake
+	})
+}
+
+// placeholderDisc returns a stand-in for a food sprite: a disc of clr.
+func placeholderDisc(clr color.RGBA) *ebiten.Image {
+	const r = placeholderSize/2 - 2
+	return placeholder(func(x, y int) color.Color {
+		dx, dy := 2*x+1-placeholderSize, 2*y+1-placeholderSize // Twice the offset from the middle
+		if dx*dx+dy*dy > 4*r*r {
+			return color.Transparent
+		}
+		return clr
+	})
+}
+
+// placeholder generates a sprite with the color of each pixel.
+func placeholder(pixel func(x, y int) color.Color) *ebiten.Image {
+	img := image.NewRGBA(image.Rect(0, 0, placeholderSize, placeholderSize))
+	for y := 0; y < placeholderSize; y++ {
+		for x := 0; x < placeholderSize; x++ {
+			img.Set(x, y, pixel(x, y))
+		}
+	}
+	return ebiten.NewImageFromImage(img)
+}
@@ -0,0 +1,132 @@
+package audio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+)
+
+// Asset paths (relative to the executable or run command location)
+const (
+	soundDir = "internal/assets/sounds"
+)
+
+// SampleRate is the sample rate of the shared audio context. Clips are
+// resampled to it on load.
+const SampleRate = 44100
+
+// Clip names, looked up as <name>.wav or <name>.ogg.
+const (
+	clipEat      = "eat"
+	clipDeath    = "death"
+	clipMenuMove = "menu_move"
+)
+
+// Manager loads the sound effects and plays them on the shared context.
+// Missing clips are logged and skipped, so the game runs silently without them.
+type Manager struct {
+	context *audio.Context
+	clips   map[string][]byte // Decoded PCM data, keyed by clip name
+	muted   bool              // Sound effects switched off in the options
+}
+
+// Context returns the process-wide audio context, creating it on first use.
+// Ebitengine allows only one context per process, so everything that plays
+// sound must go through here.
+func Context() *audio.Context {
+	if ctx := audio.CurrentContext(); ctx != nil {
+		return ctx
+	}
+	return audio.NewContext(SampleRate)
+}
+
+// NewManager creates and loads sound effects from the sounds directory on disk.
+func NewManager() *Manager {
+	return NewManagerFromFS(os.DirFS(soundDir))
+}
+
+// NewManagerFromFS creates and loads sound effects from the given file system.
+func NewManagerFromFS(fsys fs.FS) *Manager {
+	m := &Manager{
+		context: Context(),
+		clips:   make(map[string][]byte),
+	}
+	for _, name := range []string{clipEat, clipDeath, clipMenuMove} {
+		data, err := loadClip(fsys, name)
+		if err != nil {
+			log.Printf("Warning: Failed to load %s sound: %v", name, err)
+			continue // Play nothing for this clip
+		}
+		m.clips[name] = data
+	}
+	return m
+}
+
+// PlayEat plays the food-eaten sound.
+func (m *Manager) PlayEat() { m.play(clipEat) }
+
+// PlayDeath plays the player death sound.
+func (m *Manager) PlayDeath() { m.play(clipDeath) }
+
+// PlayMenuMove plays the menu cursor sound.
+func (m *Manager) PlayMenuMove() { m.play(clipMenuMove) }
+
+// Enabled reports whether sound effects are switched on.
+func (m *Manager) Enabled() bool { return !m.muted }
+
+// SetEnabled switches sound effects on or off. Music is controlled
+// separately through the MusicPlayer's volume.
+func (m *Manager) SetEnabled(enabled bool) { m.muted = !enabled }
+
+// play starts a new player for the clip so overlapping sounds don't cut each other off.
+func (m *Manager) play(name string) {
+	data, ok := m.clips[name]
+	if !ok || m.muted {
+		return
+	}
+	m.context.NewPlayerFromBytes(data).Play()
+}
+
+// loadClip reads <name>.wav, falling back to <name>.ogg, and decodes it to
+// PCM at SampleRate.
+func loadClip(fsys fs.FS, name string) ([]byte, error) {
+	var lastErr error
+	for _, ext := range []string{".wav", ".ogg"} {
+		data, err := fs.ReadFile(fsys, name+ext)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return decode(name+ext, data)
+	}
+	return nil, lastErr
+}
+
+// decode converts a WAV or Ogg Vorbis file to 16-bit stereo PCM at SampleRate.
+func decode(name string, data []byte) ([]byte, error) {
+	var stream io.Reader
+	var err error
+	switch strings.ToLower(path.Ext(name)) {
+	case ".ogg":
+		stream, err = vorbis.DecodeWithSampleRate(SampleRate, bytes.NewReader(data))
+	default:
+		stream, err = wav.DecodeWithSampleRate(SampleRate, bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", name, err)
+	}
+	pcm, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+	return pcm, nil
+}
@@ -0,0 +1,162 @@
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+
+	"snake-game/internal/storage"
+)
+
+// Track identifies a background music loop.
+type Track int
+
+const (
+	TrackMenu Track = iota
+	TrackGameplay
+)
+
+// trackFiles maps each track to its clip name in the sounds directory.
+var trackFiles = map[Track]string{
+	TrackMenu:     "music_menu",
+	TrackGameplay: "music_gameplay",
+}
+
+// trackGain scales each track relative to the chosen volume; the menu loop
+// sits quieter in the background.
+var trackGain = map[Track]float64{
+	TrackMenu:     0.6,
+	TrackGameplay: 1.0,
+}
+
+const (
+	// DefaultVolume is the music volume used until the player picks one.
+	DefaultVolume = 0.7
+
+	// duckFactor scales the volume while ducked (e.g. under the pause menu).
+	duckFactor = 0.3
+
+	// volumeFile persists the chosen music volume in the user config directory.
+	volumeFile = "audio.json"
+)
+
+// volumeSettings is the on-disk form of the saved volume.
+type volumeSettings struct {
+	MusicVolume float64 `json:"music_volume"`
+}
+
+// MusicPlayer loops one background track at a time on the shared context.
+// Missing track files are logged and played as silence.
+type MusicPlayer struct {
+	context *audio.Context
+	tracks  map[Track][]byte // Decoded PCM data per track
+	player  *audio.Player    // Currently playing loop, nil when stopped
+	current Track
+	volume  float64 // Player-chosen volume, 0..1
+	ducked  bool
+}
+
+// NewMusicPlayer loads the music tracks from the sounds directory on disk.
+func NewMusicPlayer() *MusicPlayer {
+	return NewMusicPlayerFromFS(os.DirFS(soundDir))
+}
+
+// NewMusicPlayerFromFS loads the music tracks from the given file system and
+// restores the saved volume.
+func NewMusicPlayerFromFS(fsys fs.FS) *MusicPlayer {
+	mp := &MusicPlayer{
+		context: Context(),
+		tracks:  make(map[Track][]byte),
+		volume:  loadVolume(),
+	}
+	for track, name := range trackFiles {
+		data, err := loadClip(fsys, name)
+		if err != nil {
+			log.Printf("Warning: Failed to load %s music: %v", name, err)
+			continue // Stay silent for this track
+		}
+		mp.tracks[track] = data
+	}
+	return mp
+}
+
+// Play starts looping the given track. A track that is already playing
+// keeps going rather than restarting.
+func (mp *MusicPlayer) Play(track Track) {
+	if mp.player != nil && mp.current == track {
+		return
+	}
+	mp.Stop()
+	data, ok := mp.tracks[track]
+	if !ok {
+		return
+	}
+	loop := audio.NewInfiniteLoop(bytes.NewReader(data), int64(len(data)))
+	player, err := mp.context.NewPlayer(loop)
+	if err != nil {
+		log.Printf("Warning: Failed to start music: %v", err)
+		return
+	}
+	mp.player = player
+	mp.current = track
+	mp.applyVolume()
+	mp.player.Play()
+}
+
+// Stop stops the current track, if any.
+func (mp *MusicPlayer) Stop() {
+	if mp.player == nil {
+		return
+	}
+	mp.player.Close()
+	mp.player = nil
+}
+
+// Volume returns the player-chosen music volume (0..1).
+func (mp *MusicPlayer) Volume() float64 {
+	return mp.volume
+}
+
+// SetVolume changes the music volume (clamped to 0..1) and saves it so it
+// survives restarts.
+func (mp *MusicPlayer) SetVolume(volume float64) {
+	mp.volume = min(max(volume, 0), 1)
+	mp.applyVolume()
+	if err := storage.SaveJSON(volumeFile, volumeSettings{MusicVolume: mp.volume}); err != nil {
+		log.Printf("Warning: Failed to save music volume: %v", err)
+	}
+}
+
+// Duck lowers the music (e.g. while paused) without changing the saved volume.
+func (mp *MusicPlayer) Duck(ducked bool) {
+	mp.ducked = ducked
+	mp.applyVolume()
+}
+
+// applyVolume pushes the effective volume to the active player.
+func (mp *MusicPlayer) applyVolume() {
+	if mp.player == nil {
+		return
+	}
+	v := mp.volume * trackGain[mp.current]
+	if mp.ducked {
+		v *= duckFactor
+	}
+	mp.player.SetVolume(v)
+}
+
+// loadVolume reads the saved music volume, falling back to DefaultVolume.
+func loadVolume() float64 {
+	settings := volumeSettings{MusicVolume: DefaultVolume}
+	if err := storage.LoadJSON(volumeFile, &settings); err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Printf("Warning: Failed to load music volume: %v", err)
+		}
+		return DefaultVolume
+	}
+	return min(max(settings.MusicVolume, 0), 1)
+}
@@ -83,9 +83,11 @@ func isValid(pos Position, width, height int) bool {
 }
 
 // reconstructPath builds the path from the target node back to the start.
-// Returns a slice of Positions, excluding the start, including the target.
+// Returns a slice of Positions, excluding the start, including the target:
+// one step per move, so path[0] is the first cell to move onto. The start
+// node (the one without a parent) gives an empty, non-nil path.
 func reconstructPath(targetNode *aStarNode) []Position {
-	path := []Position{}
+	path := []Position{} // Non-nil even when empty: nil means "no path"
 	current := targetNode
 	for current != nil && current.parent != nil { // Stop before adding the start node's position
 		path = append(path, current.pos)
@@ -98,21 +100,45 @@ func reconstructPath(targetNode *aStarNode) []Position {
 	return path
 }
 
-// findPath implements the A* algorithm.
-func findPath(start, target Position, width, height int, obstacles map[Position]bool) []Position {
+// stepCost returns the extra cost (on top of 1) of stepping onto a cell.
+// A nil stepCost makes every step cost the same.
+type stepCost func(pos Position) int
+
+// clearanceCost returns a stepCost that charges penalty for every obstacle
+// next to a cell, so paths keep their distance from snakes and walls where
+// a slightly longer route allows it.
+func clearanceCost(obstacles obstacleView, penalty int) stepCost {
+	neighbors := []Position{{X: 0, Y: -1}, {X: 0, Y: 1}, {X: -1, Y: 0}, {X: 1, Y: 0}}
+	return func(pos Position) int {
+		cost := 0
+		for _, offset := range neighbors {
+			if obstacles.blocked(Position{X: pos.X + offset.X, Y: pos.Y + offset.Y}) {
+				cost += penalty
+			}
+		}
+		return cost
+	}
+}
+
+// findPath implements the A* algorithm. extra, if not nil, adds a cost to
+// entering each cell; the Manhattan heuristic stays admissible since every
+// step still costs at least 1. The path excludes start and ends on target
+// (see reconstructPath): a single step for an adjacent target, empty but
+// non-nil when start is the target, and nil when target can't be reached.
+func findPath(start, target Position, width, height int, obstacles obstacleView, extra stepCost) []Position {
 	openSet := make(priorityQueue, 0)
 	heap.Init(&openSet)
 
 	closedSet := make(map[Position]bool)
 	nodeMap := make(map[Position]*aStarNode) // To quickly find existing nodes
 
-	startNode := &aStarNode{pos: start, g: 0, h: heuristic(start, target)}
+	startNode := &aStarNode{pos: start, g: 0, h: obstacles.distance(start, target)}
 	startNode.f = startNode.g + startNode.h
 	heap.Push(&openSet, startNode)
 	nodeMap[start] = startNode
 
-	// Define neighbors relative positions (no diagonals)
-	neighbors := []Position{{X: 0, Y: -1}, {X: 0, Y: 1}, {X: -1, Y: 0}, {X: 1, Y: 0}}
+	// Neighbors are the four cells around (no diagonals), across wrapping edges
+	neighbors := []Direction{DirUp, DirDown, DirLeft, DirRight}
 
 	for openSet.Len() > 0 {
 		current := heap.Pop(&openSet).(*aStarNode)
@@ -123,15 +149,18 @@ func findPath(start, target Position, width, height int, obstacles map[Position]
 
 		closedSet[current.pos] = true
 
-		for _, offset := range neighbors {
-			neighborPos := Position{X: current.pos.X + offset.X, Y: current.pos.Y + offset.Y}
+		for _, dir := range neighbors {
+			neighborPos := obstacles.step(current.pos, dir)
 
 			// Check bounds, obstacles, and if already processed
-			if !isValid(neighborPos, width, height) || obstacles[neighborPos] || closedSet[neighborPos] {
+			if !isValid(neighborPos, width, height) || obstacles.blocked(neighborPos) || closedSet[neighborPos] {
 				continue
 			}
 
 			tentativeG := current.g + 1 // Cost of moving to neighbor is 1
+			if extra != nil {
+				tentativeG += extra(neighborPos)
+			}
 
 			neighborNode, exists := nodeMap[neighborPos]
 			if !exists {
@@ -143,16 +172,52 @@ func findPath(start, target Position, width, height int, obstacles map[Position]
 				heap.Push(&openSet, neighborNode)
 				// Set costs directly here as it's the first time seeing the node
 				neighborNode.g = tentativeG
-				neighborNode.h = heuristic(neighborPos, target)
+				neighborNode.h = obstacles.distance(neighborPos, target)
 				neighborNode.f = neighborNode.g + neighborNode.h
 				heap.Fix(&openSet, neighborNode.index) // Need to fix after setting costs
 			} else if tentativeG < neighborNode.g {
 				// Found a better path to this existing node
 				neighborNode.parent = current
-				openSet.update(neighborNode, tentativeG, heuristic(neighborPos, target))
+				openSet.update(neighborNode, tentativeG, obstacles.distance(neighborPos, target))
 			}
 		}
 	}
 
 	return nil // No path found
 }
+
+// --- Reachable Space ---
+
+// floodFillCount counts the cells reachable from start (inclusive) without
+// crossing obstacles or leaving the grid (wrapping edges are crossed). Counting stops once limit cells have
+// been found; pass limit <= 0 to count everything.
+func floodFillCount(start Position, width, height int, obstacles obstacleView, limit int) int {
+	return len(floodFill(start, width, height, obstacles, limit))
+}
+
+// floodFill returns the cells floodFillCount counts, stopping the same way
+// once limit cells have been found.
+func floodFill(start Position, width, height int, obstacles obstacleView, limit int) map[Position]bool {
+	if !isValid(start, width, height) || obstacles.blocked(start) {
+		return nil
+	}
+	neighbors := []Direction{DirUp, DirDown, DirLeft, DirRight}
+	visited := map[Position]bool{start: true}
+	queue := []Position{start}
+	for len(queue) > 0 {
+		if limit > 0 && len(visited) >= limit {
+			break
+		}
+		current := queue[0]
+		queue = queue[1:]
+		for _, dir := range neighbors {
+			next := obstacles.step(current, dir)
+			if visited[next] || !isValid(next, width, height) || obstacles.blocked(next) {
+				continue
+			}
+			visited[next] = true
+			queue = append(queue, next)
+		}
+	}
+	return visited
+}
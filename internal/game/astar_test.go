@@ -0,0 +1,90 @@
+package game
+
+import "testing"
+
+// testView returns a view of a board with walls at cells.
+func testView(cells ...Position) obstacleView {
+	walls := make(map[Position]bool)
+	for _, pos := range cells {
+		walls[pos] = true
+	}
+	return obstacleView{cells: walls, zone: boardBounds(20, 20)}
+}
+
+func TestClearanceCostPrefersOpenCells(t *testing.T) {
+	// Walls line the route along the top and down the right, so the
+	// equally short route down the left and along the bottom is roomier
+	obstacles := testView(
+		Position{X: 6, Y: 4}, Position{X: 7, Y: 4}, Position{X: 8, Y: 4},
+		Position{X: 9, Y: 5}, Position{X: 9, Y: 6}, Position{X: 9, Y: 7})
+	start, target := Position{X: 5, Y: 5}, Position{X: 8, Y: 8}
+	cost := clearanceCost(obstacles, 2)
+
+	path := findPath(start, target, 20, 20, obstacles, cost)
+	if len(path) != heuristic(start, target) {
+		t.Fatalf("path %v has %d steps, want a shortest one (%d)", path, len(path), heuristic(start, target))
+	}
+	for _, pos := range path {
+		if cost(pos) > 0 {
+			t.Errorf("path %v passes next to a wall at %v", path, pos)
+		}
+	}
+}
+
+func TestFindPath(t *testing.T) {
+	start := Position{X: 5, Y: 5}
+	for _, tc := range []struct {
+		name   string
+		target Position
+		walls  []Position
+		want   int // Path length, -1 for nil
+	}{
+		{"start is target", start, nil, 0},
+		{"adjacent", Position{X: 6, Y: 5}, nil, 1},
+		{"long way round", Position{X: 7, Y: 5}, []Position{{X: 6, Y: 4}, {X: 6, Y: 5}, {X: 6, Y: 6}}, 6},
+		{"unreachable", Position{X: 10, Y: 10}, []Position{{X: 10, Y: 9}, {X: 10, Y: 11}, {X: 9, Y: 10}, {X: 11, Y: 10}}, -1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := findPath(start, tc.target, 20, 20, testView(tc.walls...), nil)
+			if tc.want < 0 {
+				if path != nil {
+					t.Errorf("path = %v, want nil", path)
+				}
+				return
+			}
+			if path == nil || len(path) != tc.want {
+				t.Fatalf("path = %v (nil %v), want %d steps", path, path == nil, tc.want)
+			}
+			prev := start
+			seen := map[Position]bool{start: true}
+			for _, pos := range path {
+				if seen[pos] {
+					t.Errorf("path %v visits %v twice (or includes the start)", path, pos)
+				}
+				if heuristic(prev, pos) != 1 {
+					t.Errorf("path %v jumps from %v to %v", path, prev, pos)
+				}
+				seen[pos] = true
+				prev = pos
+			}
+			if tc.want > 0 && path[len(path)-1] != tc.target {
+				t.Errorf("path %v doesn't end on %v", path, tc.target)
+			}
+		})
+	}
+}
+
+func TestPlanEnemyMoveReplansOffPath(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	enemy := addEnemy(g, TargetNearestFood, DirRight,
+		Position{X: 10, Y: 10}, Position{X: 9, Y: 10}, Position{X: 8, Y: 10})
+	addFood(g, Position{X: 10, Y: 14})
+	enemy.currentPath = []Position{{X: 3, Y: 2}, {X: 3, Y: 3}} // Left behind by a teleport
+
+	if dir := g.planEnemyMove(enemy); dir != DirDown {
+		t.Errorf("planEnemyMove = %v, want down towards the food", dir)
+	}
+	if len(enemy.currentPath) != 4 || enemy.currentPath[0] != (Position{X: 10, Y: 11}) {
+		t.Errorf("path = %v, want a new one from the head to the food", enemy.currentPath)
+	}
+}
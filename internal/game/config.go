@@ -0,0 +1,157 @@
+package game
+
+import "time"
+
+// Config holds the tunable rules for a game session.
+// The zero value is not meant to be used directly; start from DefaultConfig.
+type Config struct {
+	// Difficulty scales speed and enemy pressure (see DifficultyPreset).
+	Difficulty Difficulty
+
+	// GridWidth and GridHeight set the board size in cells (see BoardSize for presets).
+	GridWidth  int
+	GridHeight int
+
+	// NoSelfCollision lets the player snake pass through its own body
+	// (casual/kids mode). Walls and enemy snakes are still lethal.
+	// Growth is unaffected: eating food appends a tail segment as usual,
+	// and food never spawns on a cell covered by the snake, so overlapping
+	// segments only ever hide each other visually.
+	NoSelfCollision bool
+
+	// EnemyGracePeriod is how long enemies wander aimlessly at the start of a
+	// round before they begin pathing to food or hunting. Zero disables it.
+	EnemyGracePeriod time.Duration
+
+	// EnemySpawnDistance keeps new enemies at least this many cells
+	// (Manhattan distance) from every living player's head, and out of the
+	// lane straight ahead of it. Zero lets enemies appear anywhere free.
+	EnemySpawnDistance int
+
+	// RespawnFoodOnEat spawns a replacement food item as soon as one is eaten,
+	// keeping the board density roughly constant. When false, new food only
+	// arrives via the timed spawn (FoodSpawnInterval), making food scarcer.
+	RespawnFoodOnEat bool
+
+	// ExpireStandardFood makes standard food disappear if it isn't eaten in
+	// time, like special food always does. Off by default, so ordinary food
+	// waits for the player.
+	ExpireStandardFood bool
+
+	// ReachableFood only spawns food where a player can get to it, found
+	// with a flood fill from each living player's head around walls and
+	// snakes. Off by default, since it costs a flood fill per spawn.
+	ReachableFood bool
+
+	// SpeedCurve sets how the snakes speed up as the score rises (see
+	// SpeedCurve), and SpeedStepScore the points between speed-ups on the
+	// stepped curve. Speed never goes past MaxSpeed either way.
+	SpeedCurve     SpeedCurve
+	SpeedStepScore int
+
+	// Players is the number of human-controlled snakes sharing the board
+	// (hotseat, up to MaxPlayers; zero means one). With more than one, the
+	// round ends once at most one player is left and that player wins (see
+	// Game.Winner).
+	Players int
+
+	// Mode selects the rule set (see GameMode).
+	Mode GameMode
+
+	// StartLength is how many segments the player snakes start a round
+	// with (and come back with after a time attack crash), from
+	// MinStartLength to MaxStartLength; zero means InitialSnakeLen.
+	// StartPosition picks where player 1 starts (see StartPosition).
+	StartLength   int
+	StartPosition StartPosition
+
+	// Walls picks the board edges that wrap around instead of being walls
+	// (see WallConfig). The zero value walls every edge.
+	Walls WallConfig
+
+	// SurvivalShrinkInterval is how often the walls close in during a
+	// survival round, and SurvivalShrinkStep how many cells they advance
+	// from each edge every time.
+	SurvivalShrinkInterval time.Duration
+	SurvivalShrinkStep     int
+
+	// TimeAttackLimit is the length of a time attack round.
+	TimeAttackLimit time.Duration
+
+	// Seed seeds the game's random number generator. Zero picks a
+	// time-based seed (see Game.Seed to recover it).
+	Seed int64
+
+	// Layout places preset interior walls (see ObstacleLayout). It is
+	// ignored when a Level is loaded.
+	Layout ObstacleLayout
+
+	// Level is an optional hand-designed board (see LoadLevelFile).
+	// Nil plays on the classic open board.
+	Level *Level
+}
+
+// DefaultConfig returns the classic rule set.
+func DefaultConfig() Config {
+	return Config{
+		Difficulty:         DifficultyPreset(DifficultyNormal),
+		GridWidth:          GridWidth,
+		GridHeight:         GridHeight,
+		NoSelfCollision:    false,
+		EnemyGracePeriod:   2 * time.Second,
+		EnemySpawnDistance: 8,
+		RespawnFoodOnEat:   true,
+		SpeedCurve:         SpeedLinear,
+		SpeedStepScore:     50,
+
+		SurvivalShrinkInterval: 15 * time.Second,
+		SurvivalShrinkStep:     1,
+		TimeAttackLimit:        90 * time.Second,
+	}
+}
+
+// BoardSize is a preset board size selectable from the menu.
+type BoardSize int
+
+const (
+	BoardSmall BoardSize = iota
+	BoardMedium
+	BoardLarge
+
+	NumBoardSizes = 3
+)
+
+// Dimensions returns the board width and height in cells.
+func (b BoardSize) Dimensions() (int, int) {
+	switch b {
+	case BoardSmall:
+		return 30, 20
+	case BoardLarge:
+		return 60, 40
+	default:
+		return GridWidth, GridHeight
+	}
+}
+
+// String returns the preset's display name.
+func (b BoardSize) String() string {
+	switch b {
+	case BoardSmall:
+		return "Small"
+	case BoardLarge:
+		return "Large"
+	default:
+		return "Medium"
+	}
+}
+
+// BoardSizeFor returns the preset matching the given dimensions, or
+// BoardMedium if they don't match any preset.
+func BoardSizeFor(width, height int) BoardSize {
+	for b := BoardSize(0); b < NumBoardSizes; b++ {
+		if w, h := b.Dimensions(); w == width && h == height {
+			return b
+		}
+	}
+	return BoardMedium
+}
@@ -0,0 +1,112 @@
+package game
+
+import (
+	"math"
+	"time"
+)
+
+// DifficultyLevel names one of the difficulty presets.
+type DifficultyLevel int
+
+const (
+	DifficultyEasy DifficultyLevel = iota
+	DifficultyNormal
+	DifficultyHard
+
+	NumDifficultyLevels = 3
+)
+
+// String returns the level's display name.
+func (d DifficultyLevel) String() string {
+	switch d {
+	case DifficultyEasy:
+		return "Easy"
+	case DifficultyHard:
+		return "Hard"
+	default:
+		return "Normal"
+	}
+}
+
+// Difficulty holds the tuning that scales with the chosen difficulty.
+type Difficulty struct {
+	Level              DifficultyLevel
+	InitialSpeed       float64       // Grid cells per second at the start of a round
+	SpeedIncrement     float64       // Speed gained as the player progresses
+	NumEnemySnakes     int           // Enemies placed at the start of a round
+	MaxEnemySnakes     int           // Upper bound on enemies alive at once
+	EnemySpawnInterval time.Duration // Time between attempts to spawn another enemy
+
+	// The threat ramp (see Game.Threat) makes enemies faster and more
+	// frequent as a round goes on. A zero ThreatRampTime turns it off.
+	ThreatRampTime        time.Duration // Game time until the threat is at its highest
+	ThreatCurve           float64       // Shape of the ramp: 1 rises steadily, above 1 starts slow and ends steep
+	MaxEnemySpeedBonus    float64       // Extra enemy speed at the highest threat (0.4 is 40% faster)
+	MinEnemySpawnInterval time.Duration // What EnemySpawnInterval shrinks to at the highest threat
+}
+
+// DifficultyPreset returns the tuning for a difficulty level.
+func DifficultyPreset(level DifficultyLevel) Difficulty {
+	switch level {
+	case DifficultyEasy:
+		return Difficulty{
+			Level:                 DifficultyEasy,
+			InitialSpeed:          6,
+			SpeedIncrement:        0.3,
+			NumEnemySnakes:        1,
+			MaxEnemySnakes:        2,
+			EnemySpawnInterval:    25 * time.Second,
+			ThreatRampTime:        6 * time.Minute,
+			ThreatCurve:           1.5,
+			MaxEnemySpeedBonus:    0.25,
+			MinEnemySpawnInterval: 15 * time.Second,
+		}
+	case DifficultyHard:
+		return Difficulty{
+			Level:                 DifficultyHard,
+			InitialSpeed:          10,
+			SpeedIncrement:        0.75,
+			NumEnemySnakes:        3,
+			MaxEnemySnakes:        5,
+			EnemySpawnInterval:    10 * time.Second,
+			ThreatRampTime:        3 * time.Minute,
+			ThreatCurve:           1,
+			MaxEnemySpeedBonus:    0.5,
+			MinEnemySpawnInterval: 5 * time.Second,
+		}
+	default:
+		return Difficulty{
+			Level:                 DifficultyNormal,
+			InitialSpeed:          8,
+			SpeedIncrement:        0.5,
+			NumEnemySnakes:        2,
+			MaxEnemySnakes:        3,
+			EnemySpawnInterval:    15 * time.Second,
+			ThreatRampTime:        4 * time.Minute,
+			ThreatCurve:           1.2,
+			MaxEnemySpeedBonus:    0.4,
+			MinEnemySpawnInterval: 8 * time.Second,
+		}
+	}
+}
+
+// Threat returns how far the round's threat ramp has got, from 0 at the
+// start to 1 once ThreatRampTime has passed, following ThreatCurve. It stays
+// 0 without a ramp, and in practice, which has no enemies.
+func (g *Game) Threat() float64 {
+	d := g.Config.Difficulty
+	ramp := d.ThreatRampTime.Seconds()
+	if ramp <= 0 || g.Practice() {
+		return 0
+	}
+	curve := d.ThreatCurve
+	if curve <= 0 {
+		curve = 1
+	}
+	return math.Pow(math.Min(g.GameTime/ramp, 1), curve)
+}
+
+// enemySpeedScale returns what the threat multiplies enemy speed by.
+func (g *Game) enemySpeedScale() float64 {
+	return 1 + math.Max(g.Config.Difficulty.MaxEnemySpeedBonus, 0)*g.Threat()
+}
@@ -0,0 +1,45 @@
+package game
+
+// WallConfig says which edges of the board wrap around to the opposite side
+// instead of being walls: a snake leaving through a wrapping edge comes back
+// in through the opposite one. With only the top and bottom wrapping the
+// board is a "tube". The zero value walls every edge, the classic board.
+// Edges are those of the safe zone, so wrapping still works as the survival
+// walls close in.
+type WallConfig struct {
+	WrapTop    bool
+	WrapBottom bool
+	WrapLeft   bool
+	WrapRight  bool
+}
+
+// wrap returns p brought back inside zone through any wrapping edge it just
+// crossed; a p that left through a wall (or never left) is returned as is.
+func (w WallConfig) wrap(p Position, zone Bounds) Position {
+	switch {
+	case p.Y < zone.MinY && w.WrapTop:
+		p.Y = zone.MaxY
+	case p.Y > zone.MaxY && w.WrapBottom:
+		p.Y = zone.MinY
+	case p.X < zone.MinX && w.WrapLeft:
+		p.X = zone.MaxX
+	case p.X > zone.MaxX && w.WrapRight:
+		p.X = zone.MinX
+	}
+	return p
+}
+
+// wrapsVertically reports whether the top or bottom edge wraps.
+func (w WallConfig) wrapsVertically() bool {
+	return w.WrapTop || w.WrapBottom
+}
+
+// wrapsHorizontally reports whether the left or right edge wraps.
+func (w WallConfig) wrapsHorizontally() bool {
+	return w.WrapLeft || w.WrapRight
+}
+
+// wrapped returns p brought back onto the board through any wrapping edge.
+func (g *Game) wrapped(p Position) Position {
+	return g.Config.Walls.wrap(p, g.SafeZone)
+}
@@ -0,0 +1,198 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSelectTarget(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		policy     TargetPolicy
+		playerDead bool
+		want       Position
+	}{
+		// The food at (12,5) is nearer the enemy but right by the player
+		{"nearest food", TargetNearestFood, false, Position{X: 12, Y: 5}},
+		{"food away from player", TargetFoodAwayFromPlayer, false, Position{X: 16, Y: 12}},
+		{"shadow player", TargetShadowPlayer, false, Position{X: 11, Y: 5}},
+		{"shadow player, none alive", TargetShadowPlayer, true, Position{X: 12, Y: 5}},
+		{"cautious", TargetCautious, false, Position{X: 12, Y: 5}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGame(DefaultConfig())
+			p := g.player(1)
+			placeSnake(p, DirRight, Position{X: 10, Y: 5}, Position{X: 9, Y: 5}, Position{X: 8, Y: 5})
+			p.Dead = tc.playerDead
+			enemy := addEnemy(g, tc.policy, DirLeft,
+				Position{X: 16, Y: 5}, Position{X: 17, Y: 5}, Position{X: 18, Y: 5})
+			addFood(g, Position{X: 12, Y: 5})
+			addFood(g, Position{X: 16, Y: 12})
+
+			got, ok := g.selectTarget(enemy)
+			if !ok || got != tc.want {
+				t.Errorf("selectTarget = %v, %v; want %v, true", got, ok, tc.want)
+			}
+		})
+	}
+}
+
+func TestGracePeriod(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.EnemyGracePeriod = 2 * time.Second
+	g := newTestGame(cfg)
+	g.startGracePeriod()
+	placeSnake(g.player(1), DirRight, Position{X: 10, Y: 5}, Position{X: 9, Y: 5}, Position{X: 8, Y: 5})
+	enemy := addEnemy(g, TargetShadowPlayer, DirLeft,
+		Position{X: 16, Y: 5}, Position{X: 17, Y: 5}, Position{X: 18, Y: 5})
+
+	for _, gameTime := range []float64{0, 1, 1.99} {
+		g.GameTime = gameTime
+		if !g.InGracePeriod() {
+			t.Fatalf("InGracePeriod() = false at %v s", gameTime)
+		}
+		g.planEnemyMove(enemy)
+		if enemy.currentPath != nil {
+			t.Fatalf("enemy planned a path %v at %v s, during the grace period", enemy.currentPath, gameTime)
+		}
+	}
+
+	g.GameTime = 2
+	if g.InGracePeriod() {
+		t.Fatal("InGracePeriod() = true once it has run out")
+	}
+	if dir := g.planEnemyMove(enemy); dir != DirLeft {
+		t.Errorf("after the grace period the enemy heads %v, want towards the player", dir)
+	}
+	if n := len(enemy.currentPath); n == 0 || enemy.currentPath[n-1] != (Position{X: 11, Y: 5}) {
+		t.Errorf("after the grace period the enemy's path is %v, want one to the player's head", enemy.currentPath)
+	}
+}
+
+func TestPanicDirection(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		walls []Position
+		want  Direction
+	}{
+		{
+			name:  "single exit",
+			walls: []Position{{X: 6, Y: 5}, {X: 5, Y: 4}},
+			want:  DirDown,
+		},
+		{
+			// Up leads into a one-cell pocket, down to the open board
+			name:  "pocket or open board",
+			walls: []Position{{X: 6, Y: 5}, {X: 5, Y: 3}, {X: 4, Y: 4}, {X: 6, Y: 4}},
+			want:  DirDown,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGame(DefaultConfig())
+			setWalls(g, tc.walls...)
+			enemy := addEnemy(g, TargetNearestFood, DirRight,
+				Position{X: 5, Y: 5}, Position{X: 4, Y: 5}, Position{X: 3, Y: 5})
+
+			if got := g.panicDirection(enemy, g.obstaclesFor(enemy)); got != tc.want {
+				t.Errorf("panicDirection = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEnsureEscapeRoom(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	setWalls(g, Position{X: 7, Y: 5}, Position{X: 6, Y: 4}, Position{X: 6, Y: 6}) // A one-cell pocket at (6,5)
+	enemy := addEnemy(g, TargetNearestFood, DirRight,
+		Position{X: 5, Y: 5}, Position{X: 4, Y: 5}, Position{X: 3, Y: 5})
+
+	enemy.NextDir = DirRight
+	g.ensureEscapeRoom(enemy)
+	if enemy.NextDir == DirRight {
+		t.Error("enemy kept heading into a pocket too small to turn around in")
+	}
+
+	enemy.NextDir = DirUp
+	g.ensureEscapeRoom(enemy)
+	if enemy.NextDir != DirUp {
+		t.Errorf("a move onto the open board was changed to %v", enemy.NextDir)
+	}
+}
+
+func TestFindClosestFood(t *testing.T) {
+	from := Position{X: 5, Y: 5}
+	food := func(x, y int, typ FoodType, points int) *Food {
+		return &Food{Pos: Position{X: x, Y: y}, Type: typ, Points: points}
+	}
+	for _, tc := range []struct {
+		name  string
+		items []*Food
+		want  int // Index into items, -1 for none
+	}{
+		{"empty board", nil, -1},
+		{"only bombs", []*Food{food(6, 5, FoodTypeBomb, 0)}, -1},
+		{"same score and distance: first listed", []*Food{food(5, 8, FoodTypeStandard, 10), food(8, 5, FoodTypeStandard, 10)}, 0},
+		{"same score: nearer", []*Food{food(7, 5, FoodTypeSpeedUp, 15), food(6, 5, FoodTypeStandard, 10)}, 1},
+		{"more points per step", []*Food{food(6, 5, FoodTypeStandard, 10), food(15, 5, FoodTypeGolden, goldenPoints)}, 1},
+		{"bomb next door", []*Food{food(6, 5, FoodTypeBomb, 0), food(9, 5, FoodTypeStandard, 10)}, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGame(DefaultConfig())
+			g.FoodItems = tc.items
+			got := g.findClosestFood(from)
+			var want *Food
+			if tc.want >= 0 {
+				want = tc.items[tc.want]
+			}
+			if got != want {
+				t.Errorf("findClosestFood = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestEnemyHeadOnResolvedOnce(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	// Walled in nose to nose: each enemy's only move is onto the other's head
+	setWalls(g, Position{X: 4, Y: 4}, Position{X: 4, Y: 6}, Position{X: 5, Y: 4}, Position{X: 5, Y: 6})
+	a := addEnemy(g, TargetNearestFood, DirRight, Position{X: 4, Y: 5}, Position{X: 3, Y: 5}, Position{X: 2, Y: 5})
+	b := addEnemy(g, TargetNearestFood, DirLeft, Position{X: 5, Y: 5}, Position{X: 6, Y: 5}, Position{X: 7, Y: 5})
+	a.MoveProgress, b.MoveProgress = 1, 1
+	hooked := map[*Snake]int{}
+	g.OnEnemyDeath = func(s *Snake) { hooked[s]++ }
+
+	if err := g.Update(0); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.EnemySnakes) != 0 || !a.Dead || !b.Dead {
+		t.Fatalf("%d enemies left, a dead %v, b dead %v; want both gone", len(g.EnemySnakes), a.Dead, b.Dead)
+	}
+	if hooked[a] != 1 || hooked[b] != 1 {
+		t.Errorf("OnEnemyDeath called %d times for a and %d for b, want once each", hooked[a], hooked[b])
+	}
+	died := map[*Snake]int{}
+	spawned := 0
+	for _, ev := range g.DrainEvents() {
+		switch ev.Type {
+		case EventEnemyDied:
+			died[ev.Snake]++
+		case EventFoodSpawned:
+			spawned++
+		}
+	}
+	if died[a] != 1 || died[b] != 1 || len(died) != 2 {
+		t.Errorf("death events per snake = %v, want one each for a and b", died)
+	}
+	// b (moving first) drops on (6,5), its cell at (4,5) still being under
+	// a; then a drops on (4,5) and (2,5)
+	if len(g.FoodItems) != 3 || spawned != 3 {
+		t.Errorf("%d food items (%d spawn events) after the crash, want 3", len(g.FoodItems), spawned)
+	}
+	seen := map[Position]bool{}
+	for _, food := range g.FoodItems {
+		if seen[food.Pos] {
+			t.Errorf("two food items dropped on %v", food.Pos)
+		}
+		seen[food.Pos] = true
+	}
+}
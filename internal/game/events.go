@@ -0,0 +1,56 @@
+package game
+
+// EventType identifies what happened in a GameEvent.
+type EventType int
+
+const (
+	EventFoodEaten         EventType = iota // Snake ate Food at Pos
+	EventFoodSpawned                        // Food appeared at Pos
+	EventEnemySpawned                       // Snake (an enemy) appeared with its head at Pos
+	EventEnemyDied                          // Snake (an enemy) died with its head at Pos
+	EventPlayerDied                         // Snake (a player) died with its head at Pos and its body on Body
+	EventSpeedBoostStarted                  // Snake started a speed effect (see Snake.SpeedFactor)
+	EventBombExploded                       // Snake ran into the bomb Food at Pos (and died)
+	EventGameOver                           // The round ended
+)
+
+// maxQueuedEvents bounds the queue when nothing drains it (e.g. a headless
+// simulation); the oldest events are dropped first.
+const maxQueuedEvents = 256
+
+// GameEvent is something that happened during Update, for presentation code
+// (particles, sounds, screen shake) to react to. Fields that don't apply to
+// the event type are zero.
+type GameEvent struct {
+	Type  EventType
+	Time  float64 // GameTime when it happened
+	Pos   Position
+	Snake *Snake
+	Food  *Food
+	Body  []Position // EventPlayerDied: a copy of the body Snake died with (in time attack Snake is already back at its start)
+}
+
+// DrainEvents returns the events queued since the last call, oldest first,
+// and empties the queue.
+func (g *Game) DrainEvents() []GameEvent {
+	events := g.events
+	g.events = nil
+	return events
+}
+
+// emit queues an event for DrainEvents.
+func (g *Game) emit(ev GameEvent) {
+	if len(g.events) >= maxQueuedEvents {
+		g.events = g.events[1:]
+	}
+	ev.Time = g.GameTime
+	g.events = append(g.events, ev)
+}
+
+// headOf returns the snake's head, or the zero position for an empty snake.
+func headOf(s *Snake) Position {
+	if len(s.Body) == 0 {
+		return Position{}
+	}
+	return s.Body[0]
+}
@@ -0,0 +1,54 @@
+package game
+
+import "testing"
+
+func TestRespawnFoodOnEat(t *testing.T) {
+	for _, tc := range []struct {
+		respawn bool
+		want    int
+	}{
+		{true, 3},
+		{false, 2},
+	} {
+		cfg := DefaultConfig()
+		cfg.RespawnFoodOnEat = tc.respawn
+		g := newTestGame(cfg)
+		placeSnake(g.player(1), DirRight, Position{X: 10, Y: 5}, Position{X: 9, Y: 5}, Position{X: 8, Y: 5})
+		eaten := addFood(g, Position{X: 11, Y: 5})
+		addFood(g, Position{X: 20, Y: 20})
+		addFood(g, Position{X: 30, Y: 10})
+
+		stepPlayer(t, g)
+		if got := len(g.FoodItems); got != tc.want {
+			t.Errorf("RespawnFoodOnEat %v: %d food items after eating one of 3, want %d", tc.respawn, got, tc.want)
+		}
+		for _, food := range g.FoodItems {
+			if food == eaten {
+				t.Errorf("RespawnFoodOnEat %v: the eaten food is still on the board", tc.respawn)
+			}
+		}
+	}
+}
+
+func TestFoodSpawnRunsOnGameTime(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Difficulty.InitialSpeed = 0 // Keep the player still
+	g := newTestGame(cfg)
+	g.SetTimeScale(0.5)
+	g.foodSpawnTimer = g.foodSpawnInterval()
+	interval := FoodSpawnInterval.Seconds()
+
+	for frame := 0; g.GameTime < 2*interval; frame++ {
+		g.IsPaused = frame >= 4 && frame < 40 // A long pause must not count
+		if err := g.Update(0.5); err != nil {
+			t.Fatal(err)
+		}
+		if g.IsOver {
+			t.Fatal("player died") // The clock would stop for good
+		}
+		want := int(g.GameTime / interval)
+		if got := len(g.FoodItems); got != want {
+			t.Fatalf("%d food items after %v s of game time, want %d", got, g.GameTime, want)
+		}
+	}
+}
@@ -2,8 +2,10 @@ package game
 
 import (
 	// Need heap for astar.go (if not already imported)
+	"image/color"
 	"log"
 	"math/rand"
+	"slices"
 	"time"
 	// Import log for debugging if needed
 	// "log"
@@ -11,20 +13,34 @@ import (
 
 // --- Constants ---
 
+// Speed and enemy tuning depends on the difficulty (see DifficultyPreset).
 const (
-	GridWidth          = 40
-	GridHeight         = 30
-	InitialSpeed       = 8 // Grid cells per second
-	SpeedIncrement     = 0.5
-	MaxSpeed           = 20
-	InitialSnakeLen    = 3
-	InitialFoodItems   = 3                // Start with this many food items
-	MaxTotalFoodItems  = 50               // Maximum food items on screen
-	FoodSpawnInterval  = 5 * time.Second  // Time between new food spawns
-	NumEnemySnakes     = 2                // Initial number of enemies
-	MaxEnemySnakes     = 3                // Maximum number of enemies allowed
-	EnemySpawnInterval = 15 * time.Second // Time between trying to spawn new enemies
-	foodFlashDuration  = 150 * time.Millisecond
+	GridWidth         = 40 // Default board width (see Config.GridWidth)
+	GridHeight        = 30 // Default board height (see Config.GridHeight)
+	MaxSpeed          = 20
+	InitialSnakeLen   = 3
+	MaxPlayers        = 4                       // Most human players on one board (see Config.Players)
+	LengthBonusPoints = 5                       // Points per segment of the player's snake added to the score at game over
+	MinSnakeLen       = 2                       // Shrink food never makes a snake shorter than this
+	MaxShields        = 3                       // Most shields a snake can hold at once
+	MaxSpeedEffect    = 15 * time.Second        // Longest a stack of speed effects can run (see applySpeedBoost)
+	InitialFoodItems  = 3                       // Start with this many food items
+	MaxTotalFoodItems = 50                      // Maximum food items on screen
+	FoodSpawnInterval = 5 * time.Second         // Time between new food spawns
+	ComboWindow       = 3 * time.Second         // Eat again within this time to extend the combo
+	shrinkNoticeTime  = 1500 * time.Millisecond // How long the HUD shows a shrink
+	inputQueueSize    = 3                       // Turns a player can queue ahead of the snake
+	bombLifetime      = 10 * time.Second        // How long a bomb stays on the board
+	portalLifetime    = 10 * time.Second        // How long portal food stays on the board
+	goldenLifetime    = 6 * time.Second         // How long a golden apple stays on the board
+	goldenPoints      = 100                     // Base points for a golden apple (the combo still applies)
+	ghostDuration     = 5 * time.Second         // How long ghost food lets a snake pass through snakes
+	deathDropSpacing  = 2                       // A dying enemy drops food on every this many body segments
+	maxDeathDrops     = 4                       // Most food items one dying enemy drops
+	specialLifetime   = 15 * time.Second        // How long other special food stays on the board
+	standardLifetime  = 25 * time.Second        // How long standard food stays, with Config.ExpireStandardFood
+	FoodExpiryWarning = 1 * time.Second         // Food blinks for this long before it disappears
+	bombClearance     = 3                       // Bombs never appear within this many cells of a player's head
 )
 
 // --- Types ---
@@ -45,21 +61,95 @@ type Position struct {
 	X, Y int
 }
 
+// Delta returns the change in X and Y of one cell's move in the direction
+// (0, 0 for DirNone).
+func (d Direction) Delta() (dx, dy int) {
+	switch d {
+	case DirUp:
+		return 0, -1
+	case DirDown:
+		return 0, 1
+	case DirLeft:
+		return -1, 0
+	case DirRight:
+		return 1, 0
+	}
+	return 0, 0
+}
+
+// step returns the neighbouring position one cell away in the given direction.
+func (p Position) step(dir Direction) Position {
+	dx, dy := dir.Delta()
+	return Position{X: p.X + dx, Y: p.Y + dy}
+}
+
 // Snake struct holds state for a single snake (player or AI)
 type Snake struct {
-	Body               []Position
-	PrevBody           []Position // Stores body positions from the *previous completed* move step
-	Direction          Direction
-	NextDir            Direction   // Buffer for next direction input
-	SpeedFactor        float64     // Multiplier for speed (1.0 = normal, >1 = faster, <1 = slower)
-	SpeedTimer         *time.Timer // Timer for temporary speed effects
-	SpeedEffectEndTime time.Time   // Track when the speed boost ends
-	IsPlayer           bool        // Flag to distinguish player snake
-	MoveProgress       float64     // How far into the current grid move (0.0 to 1.0)
-	currentPath        []Position  // Path for AI snakes
+	Body            []Position
+	PrevBody        []Position // PrevBody[i] is where Body[i] was before the last move step (same length as Body)
+	Direction       Direction
+	PrevDirection   Direction     // Direction of the move step before the current one, for turning the head smoothly
+	NextDir         Direction     // Direction for the next move step
+	inputQueue      []Direction   // Player turns waiting for their move step (see steer)
+	SpeedFactor     float64       // Multiplier for speed (1.0 = normal, >1 = faster, <1 = slower)
+	SpeedEffectLeft float64       // Seconds of game time left on the current speed effect
+	SpeedEffectFull float64       // Full length (s) of the current speed effect, for drawing how much is left
+	IsPlayer        bool          // Flag to distinguish player snake
+	Dead            bool          // Set when the snake dies: a player's round ends with the current step, an enemy is off the board
+	ShieldCount     int           // Wall/self collisions the snake will survive (see FoodTypeShield)
+	GhostUntil      float64       // GameTime until which the snake passes through snakes (see FoodTypeGhost)
+	Stamina         float64       // Boost left for a player, 0 to 1 (see SetBoost)
+	Boosting        bool          // The player is boosting this frame
+	boostHeld       bool          // The player is holding the boost key
+	MoveProgress    float64       // How far into the current grid move (0.0 to 1.0)
+	TargetPolicy    TargetPolicy  // What an AI snake steers towards (ignored for the player)
+	Strategy        EnemyStrategy // How an AI snake picks its moves; nil means GreedyAStarStrategy (ignored for the player)
+	Hunting         bool          // An enemy is chasing the nearest player for now, whatever its policy (see tickHunt)
+	huntTimer       float64       // Game time (s) until an enemy's hunt ends or it next checks for starting one
+	huntRetarget    float64       // Game time (s) until a hunter re-aims at the player
+	Color           color.RGBA    // Tint for an enemy's sprites, from EnemyPalette (zero for players)
+	pendingGrowth   int           // Segments to add on the next move steps
+	teleportTo      *Position     // Where the head comes out on this move step, if a portal was eaten
+	currentPath     []Position    // Path for AI snakes
 	// Add other snake-specific properties if needed (e.g., color for rendering)
 }
 
+// TargetPolicy selects what an enemy snake paths towards. It is the enemy's
+// personality: greedy (nearest food), shy (food away from the player),
+// aggressive (shadow the player) or cautious.
+type TargetPolicy int
+
+const (
+	TargetNearestFood        TargetPolicy = iota // Closest food item (classic behavior)
+	TargetFoodAwayFromPlayer                     // Closest food, avoiding food near the player
+	TargetShadowPlayer                           // Tail the player's head
+	TargetCautious                               // Closest food, but only through wide open space
+
+	numTargetPolicies = 4
+)
+
+// playerAvoidRadius is the distance from the player's head inside which
+// TargetFoodAwayFromPlayer enemies consider food "contested".
+const playerAvoidRadius = 8
+
+// cautiousRoomFactor scales the room (in multiples of its length) a
+// TargetCautious enemy insists on before committing to a move.
+const cautiousRoomFactor = 3
+
+// cautiousClearancePenalty is the extra path cost a TargetCautious enemy
+// gives each obstacle next to a cell, so it routes around tight spots.
+const cautiousClearancePenalty = 2
+
+// EnemyPalette holds the colors handed out to enemies, so those alive at the
+// same time can be told apart.
+var EnemyPalette = []color.RGBA{
+	{R: 255, G: 80, B: 0, A: 255},    // Orange
+	{R: 230, G: 60, B: 200, A: 255},  // Magenta
+	{R: 255, G: 220, B: 40, A: 255},  // Yellow
+	{R: 150, G: 90, B: 255, A: 255},  // Violet
+	{R: 240, G: 240, B: 240, A: 255}, // White
+}
+
 // FoodType defines the kind of food
 type FoodType int
 
@@ -67,75 +157,222 @@ const (
 	FoodTypeStandard FoodType = iota
 	FoodTypeSpeedUp
 	FoodTypeSlowDown
+	FoodTypeTeleport // Carries the eater to a random free cell; the body follows through
+	FoodTypeShrink   // Takes 1-2 segments off the eater's tail (never below MinSnakeLen)
+	FoodTypeShield   // Grants one shield, which turns a wall or self collision into a bounce
+	FoodTypeBomb     // A hazard: any snake whose head enters it dies; vanishes after bombLifetime
+	FoodTypeGolden   // Rare and worth goldenPoints, but only stays for goldenLifetime
+	FoodTypeGhost    // The eater passes through snakes (its own body included) for ghostDuration
 )
 
 // Food struct holds state for a food item
 type Food struct {
-	Pos      Position
-	Type     FoodType
-	Points   int
-	Effect   func(*Snake)  // Function to apply the food's effect
-	Duration time.Duration // Duration for temporary effects
+	Pos       Position
+	Type      FoodType
+	Points    int
+	Effect    func(*Snake)  // Function to apply the food's effect
+	Duration  time.Duration // Duration for temporary effects
+	SpawnTime float64       // GameTime when the item appeared
+	Lifetime  time.Duration // How long it stays on the board; 0 = until eaten
 	// Add rendering-specific info later (e.g., sprite name)
 }
 
 // Game struct holds the entire game state
 type Game struct {
-	PlayerSnake        *Snake
-	EnemySnakes        []*Snake
-	FoodItems          []*Food
-	Score              int
-	Speed              float64 // Base grid cells per second for player
-	IsOver             bool
-	IsPaused           bool
-	nextFoodSpawnTime  time.Time // When the next food item should appear
-	nextEnemySpawnTime time.Time // When to next check for enemy spawning
-	FoodEatenPos       *Position // Position where food was last eaten
-	FoodEatenTime      time.Time // Time when food was last eaten
-	EnemyFoodEatenPos  *Position // Position where an enemy last ate food
+	Players         []*Snake // Human snakes, player 1 first; a player who dies stays in place, marked Dead
+	EnemySnakes     []*Snake
+	FoodItems       []*Food
+	Scores          []int   // Parallel to Players
+	LengthBonus     int     // Part of player 1's score awarded for their length when a single-player round ended
+	Winner          int     // After a multiplayer round: the player (1 to MaxPlayers) left alive, 0 for a draw
+	Speed           float64 // Base grid cells per second for player
+	IsOver          bool
+	IsPaused        bool
+	TimeScale       float64     // Game seconds per real second; 0 means normal speed (see SetTimeScale)
+	GameTime        float64     // Seconds of unpaused play since the round started
+	TimeRemaining   float64     // Game time (s) left on the clock in time attack; 0 in the other modes
+	StepCount       int         // Number of finalized player moves this round
+	foodSpawnTimer  float64     // Game time (s) left until the next food item appears
+	enemySpawnTimer float64     // Game time (s) left until the next enemy spawn check
+	graceEndTime    float64     // GameTime until which enemies only wander
+	ComboCount      int         // Foods the player ate in a row, each within ComboWindow of the last
+	ComboExpiry     float64     // GameTime when the current combo lapses
+	ShrunkBy        int         // Segments the player lost to the last shrink food
+	ShrinkTime      float64     // GameTime of that shrink
+	events          []GameEvent // Queued for DrainEvents
+	Width           int         // Board width in cells
+	Height          int         // Board height in cells
+	Obstacles       []Position  // Interior wall cells (from the level or layout)
+	obstacleSet     map[Position]bool
+	obstacleCache   map[Position]bool // Shared pathfinding obstacles (see obstaclesFor); nil when stale
+	SafeZone        Bounds            // Cells still playable; the whole board outside survival mode
+	NextSafeZone    Bounds            // Where the walls close in to next; equals SafeZone until the warning starts
+	safeZoneTimer   float64           // Game time (s) left until the walls close in
+	Config          Config            // Rules for this session
+	Recorder        *Recorder         // Records the player inputs of the round when set (see NewRecorder)
+	Replaying       bool              // The round is a recorded one being played back (see Playback)
+	seed            int64
+	roundSeed       int64      // Seed the current round started from (see RoundSeed)
+	rng             *rand.Rand // All game randomness goes through here, so a seed replays a round
+
+	// Optional hooks for presentation code (sounds etc.); the game never
+	// depends on them being set.
+	OnPlayerEat  func(food *Food)   // Called when the player eats a food item
+	OnEnemyDeath func(enemy *Snake) // Called when an enemy snake dies
+	OnGameOver   func()             // Called once when the round ends
 }
 
 // --- Game Initialization ---
 
-// NewGame initializes a new game state
+// NewGame initializes a new game state with the default rules
 func NewGame() *Game {
+	return NewGameWithConfig(DefaultConfig())
+}
+
+// NewGameWithSeed initializes a game with the default rules whose first
+// round (food, enemies, AI wandering) is fully determined by seed.
+func NewGameWithSeed(seed int64) *Game {
+	cfg := DefaultConfig()
+	cfg.Seed = seed
+	return NewGameWithConfig(cfg)
+}
+
+// NewGameWithConfig initializes a new game state using the given rules
+func NewGameWithConfig(cfg Config) *Game {
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
 	g := &Game{
-		Speed:     InitialSpeed,
+		Speed:     cfg.Difficulty.InitialSpeed,
 		FoodItems: make([]*Food, 0, 5), // Initialize with some capacity
+		Config:    cfg,
+		seed:      seed,
+		rng:       rand.New(rand.NewSource(seed)),
 	}
-	g.Reset()
+	g.applyBoardSize()
+	g.ResetWithSeed(seed)
 	return g
 }
 
-// Reset initializes or resets the game state for a new round
+// Seed returns the seed the game's random number generator started from.
+// Passing it back in Config.Seed reproduces the first round exactly.
+func (g *Game) Seed() int64 {
+	return g.seed
+}
+
+// applyBoardSize sets Width/Height from the config. A level defines its own
+// board, overriding the configured size.
+func (g *Game) applyBoardSize() {
+	g.Width, g.Height = g.Config.GridWidth, g.Config.GridHeight
+	if g.Config.Level != nil {
+		g.Width, g.Height = g.Config.Level.Width, g.Config.Level.Height
+	}
+}
+
+// SetBoardSize changes the board dimensions and starts a fresh round on it.
+// It has no effect while a level is loaded, since the level fixes the size.
+func (g *Game) SetBoardSize(width, height int) {
+	if g.Config.Level != nil {
+		return
+	}
+	g.Config.GridWidth, g.Config.GridHeight = width, height
+	g.applyBoardSize()
+	g.Reset()
+}
+
+// SetDifficulty switches to a difficulty preset and starts a fresh round with it.
+func (g *Game) SetDifficulty(level DifficultyLevel) {
+	g.Config.Difficulty = DifficultyPreset(level)
+	g.Reset()
+}
+
+// SetPlayers sets the number of human players (1 to MaxPlayers) and starts a
+// fresh round.
+func (g *Game) SetPlayers(n int) {
+	g.Config.Players = min(max(n, 1), MaxPlayers)
+	g.Reset()
+}
+
+// PlayerCount returns the number of human players the rules call for.
+func (c Config) PlayerCount() int {
+	return min(max(c.Players, 1), MaxPlayers)
+}
+
+// SetLayout changes the preset wall layout and starts a fresh round with it.
+// Like the board size, it has no effect while a level is loaded.
+func (g *Game) SetLayout(layout ObstacleLayout) {
+	if g.Config.Level != nil {
+		return
+	}
+	g.Config.Layout = layout
+	g.Reset()
+}
+
+// RoundSeed returns the seed the current round started from. Passing it to
+// ResetWithSeed (with the same Config) replays the round from the start.
+func (g *Game) RoundSeed() int64 {
+	return g.roundSeed
+}
+
+// Reset initializes or resets the game state for a new round. Each round
+// draws a fresh seed from the game's generator, so the sequence of rounds is
+// still fixed by Config.Seed while any single round can be replayed alone.
 func (g *Game) Reset() {
+	g.ResetWithSeed(g.rng.Int63())
+}
+
+// ResetWithSeed starts a new round whose randomness is fully determined by seed.
+func (g *Game) ResetWithSeed(seed int64) {
+	g.roundSeed = seed
+	g.rng.Seed(seed)
+	g.invalidateObstacles()
+	g.Replaying = false
+
 	occupied := make(map[Position]bool) // Track occupied spots during init
 
-	// Initialize player snake
-	startX, startY := GridWidth/4, GridHeight/2 // Start player on left side
-	initialBody := make([]Position, InitialSnakeLen)
-	prevBody := make([]Position, InitialSnakeLen)
-	for i := 0; i < InitialSnakeLen; i++ {
-		pos := Position{X: startX - i, Y: startY}
-		initialBody[i] = pos
-		prevBody[i] = pos
-		occupied[pos] = true
+	starts := g.playerStarts(g.firstPlayerStart())
+
+	// Lay out the walls (level or preset layout) before placing anything else
+	g.Obstacles = nil
+	g.obstacleSet = make(map[Position]bool)
+	if g.Config.Level != nil {
+		g.Obstacles = append(g.Obstacles, g.Config.Level.Walls...)
+	} else {
+		heads := make([]Position, len(starts))
+		for i, start := range starts {
+			heads[i] = start.Pos
+		}
+		g.Obstacles = generateLayout(g.rng, g.Config.Layout, g.Width, g.Height, heads...)
 	}
-	g.PlayerSnake = &Snake{
-		Body:               initialBody,
-		PrevBody:           prevBody,
-		Direction:          DirRight,
-		NextDir:            DirRight,
-		SpeedFactor:        1.0,
-		SpeedEffectEndTime: time.Time{},
-		IsPlayer:           true,
-		MoveProgress:       0.0,
-		currentPath:        nil,
+	for _, pos := range g.Obstacles {
+		g.obstacleSet[pos] = true
+	}
+	g.resetSafeZone()
+	g.resetTimeAttack()
+	g.markObstacles(occupied)
+
+	// Initialize the player snakes
+	g.Players = make([]*Snake, 0, len(starts))
+	for i, start := range starts {
+		player := g.createPlayer(start, occupied)
+		if player == nil {
+			log.Printf("Warning: Could not place player %d", i+1)
+			break // Later players would be numbered wrongly
+		}
+		g.Players = append(g.Players, player)
+		for _, seg := range player.Body {
+			occupied[seg] = true
+		}
 	}
 
 	// Initialize Enemies
-	g.EnemySnakes = make([]*Snake, 0, MaxEnemySnakes)
-	for i := 0; i < NumEnemySnakes; i++ {
+	g.EnemySnakes = make([]*Snake, 0, g.Config.Difficulty.MaxEnemySnakes)
+	numEnemies := g.Config.Difficulty.NumEnemySnakes
+	if g.Practice() {
+		numEnemies = 0
+	}
+	for i := 0; i < numEnemies; i++ {
 		enemy := g.createEnemy(occupied)
 		if enemy != nil {
 			g.EnemySnakes = append(g.EnemySnakes, enemy)
@@ -145,47 +382,174 @@ func (g *Game) Reset() {
 		}
 	}
 
-	g.Score = 0
-	g.Speed = InitialSpeed
+	g.Scores = make([]int, len(g.Players))
+	g.LengthBonus = 0
+	g.Winner = 0
+	g.Speed = g.Config.Difficulty.InitialSpeed
 	g.IsOver = false
 	g.IsPaused = false
+	g.GameTime = 0
+	g.StepCount = 0
+	g.ComboCount = 0
+	g.ComboExpiry = 0
 	g.FoodItems = g.FoodItems[:0] // Clear existing food
-	g.FoodEatenPos = nil          // Reset food eaten effect tracker
-	g.FoodEatenTime = time.Time{}
-	g.EnemyFoodEatenPos = nil // Reset enemy food effect tracker
+	g.ShrunkBy = 0
 
 	// Spawn initial food items (avoiding snakes)
 	for i := 0; i < InitialFoodItems; i++ {
 		g.spawnFoodItem()
 	}
+	g.events = nil // The opening board isn't "new", and the last round's events are stale
+
+	g.foodSpawnTimer = g.foodSpawnInterval()
+	g.enemySpawnTimer = g.enemySpawnInterval() // First enemy spawn check
+	g.startGracePeriod()
+}
+
+// startGracePeriod gives the player a moment before enemies start pursuing.
+func (g *Game) startGracePeriod() {
+	g.graceEndTime = g.GameTime + g.Config.EnemyGracePeriod.Seconds()
+}
+
+// InGracePeriod reports whether enemies are still holding off.
+func (g *Game) InGracePeriod() bool {
+	return g.GameTime < g.graceEndTime
+}
+
+// playerStart is where a player's head starts and the way it heads off.
+type playerStart struct {
+	Pos Position
+	Dir Direction
+}
+
+// playerStarts returns the start of each player, given player 1's. Player 1
+// heads right from the left side and player 2 mirrors them on the right;
+// players 3 and 4 do the same a quarter of the way from the top and bottom.
+func (g *Game) playerStarts(first Position) []playerStart {
+	mirrorX := g.Width - 1 - first.X
+	starts := []playerStart{
+		{Pos: first, Dir: DirRight},
+		{Pos: Position{X: mirrorX, Y: first.Y}, Dir: DirLeft},
+		{Pos: Position{X: first.X, Y: g.Height / 4}, Dir: DirRight},
+		{Pos: Position{X: mirrorX, Y: g.Height - 1 - g.Height/4}, Dir: DirLeft},
+	}
+	return starts[:g.Config.PlayerCount()]
+}
+
+// createPlayer places a player at start with its body (startLength
+// segments) trailing straight behind the head. A head too close to the edge
+// behind it moves forward until the body fits on the board. If that spot is
+// blocked (e.g. by a level's walls or another player) the nearest free row
+// is used instead; nil means there was no room at all.
+func (g *Game) createPlayer(start playerStart, occupied map[Position]bool) *Snake {
+	length := g.startLength()
+	back := 1 // Columns from one segment to the next, towards the tail
+	if start.Dir == DirRight {
+		back = -1
+		start.Pos.X = max(start.Pos.X, length-1)
+	} else {
+		start.Pos.X = min(start.Pos.X, g.Width-length)
+	}
+	fits := func(head Position) bool {
+		for i := 0; i < length; i++ {
+			pos := Position{X: head.X + i*back, Y: head.Y}
+			if occupied[pos] || !isValid(pos, g.Width, g.Height) {
+				return false
+			}
+		}
+		return true
+	}
+	for dy := 0; dy < g.Height; dy++ {
+		for _, y := range []int{start.Pos.Y + dy, start.Pos.Y - dy} {
+			head := Position{X: start.Pos.X, Y: y}
+			if !fits(head) {
+				continue
+			}
+			body := make([]Position, length)
+			for i := range body {
+				body[i] = Position{X: head.X + i*back, Y: head.Y}
+			}
+			return &Snake{
+				Body:          body,
+				PrevBody:      append([]Position(nil), body...),
+				Direction:     start.Dir,
+				PrevDirection: start.Dir,
+				NextDir:       start.Dir,
+				SpeedFactor:   1.0,
+				Stamina:       1,
+				IsPlayer:      true,
+			}
+		}
+	}
+	return nil
+}
 
-	g.scheduleNextFoodSpawn()
-	g.scheduleNextEnemySpawn() // Schedule first enemy spawn check
+// player returns the snake of player n (1-based), or nil if there is none.
+func (g *Game) player(n int) *Snake {
+	if n < 1 || n > len(g.Players) {
+		return nil
+	}
+	return g.Players[n-1]
+}
+
+// livingPlayerHeads returns the heads of the players still alive.
+func (g *Game) livingPlayerHeads() []Position {
+	var heads []Position
+	for _, player := range g.Players {
+		if !player.Dead && len(player.Body) > 0 {
+			heads = append(heads, player.Body[0])
+		}
+	}
+	return heads
 }
 
-// createEnemy initializes a single enemy snake at a valid position.
+// createEnemy initializes a single enemy snake at a valid position: on free
+// cells and away from the players (see nearPlayers).
 func (g *Game) createEnemy(occupied map[Position]bool) *Snake {
 	attempts := 0
-	maxAttempts := (GridWidth * GridHeight) / 2 // Limit attempts
+	maxAttempts := (g.Width * g.Height) / 2 // Limit attempts
+
+	// Mix target policies across a wave so some enemies farm food and some hunt
+	policy := TargetPolicy(len(g.EnemySnakes) % numTargetPolicies)
+	strategy := g.pickEnemyStrategy()
+
+	// Levels with spawn slots only ever place enemies on those slots
+	var spawnSlots []Position
+	if g.Config.Level != nil && len(g.Config.Level.EnemySpawns) > 0 {
+		slots := g.Config.Level.EnemySpawns
+		for _, i := range g.rng.Perm(len(slots)) {
+			spawnSlots = append(spawnSlots, slots[i])
+		}
+		maxAttempts = len(spawnSlots)
+	}
 
 	for attempts < maxAttempts {
-		// Try placing on the right side initially
-		startX := GridWidth - GridWidth/4 + rand.Intn(GridWidth/4)
-		startY := rand.Intn(GridHeight)
+		// Try placing on the right side (of the safe zone) initially
+		zone := g.SafeZone
+		quarter := max(zone.Width()/4, 1)
+		startX := zone.MaxX + 1 - quarter + g.rng.Intn(quarter)
+		startY := zone.MinY + g.rng.Intn(zone.Height())
+		if spawnSlots != nil {
+			startX, startY = spawnSlots[attempts].X, spawnSlots[attempts].Y
+		}
 		startDir := DirLeft // Start moving left
 
-		// Check if start position + initial body is clear
+		// Check if start position + initial body is clear. Enemies always
+		// start InitialSnakeLen long; Config.StartLength is for the players.
 		validPlacement := true
 		tempBody := make([]Position, InitialSnakeLen)
 		for i := 0; i < InitialSnakeLen; i++ {
 			// Calculate initial body based on startDir (simplified: assumes left)
 			pos := Position{X: startX + i, Y: startY}
-			if occupied[pos] || pos.X >= GridWidth || pos.X < 0 || pos.Y >= GridHeight || pos.Y < 0 {
+			if occupied[pos] || !isValid(pos, g.Width, g.Height) {
 				validPlacement = false
 				break
 			}
 			tempBody[i] = pos
 		}
+		if validPlacement && g.nearPlayers(tempBody) {
+			validPlacement = false // Too close to a player: try elsewhere
+		}
 
 		if validPlacement {
 			initialBody := make([]Position, InitialSnakeLen)
@@ -196,15 +560,18 @@ func (g *Game) createEnemy(occupied map[Position]bool) *Snake {
 				prevBody[i] = pos
 			}
 			return &Snake{
-				Body:               initialBody,
-				PrevBody:           prevBody,
-				Direction:          startDir,
-				NextDir:            startDir,
-				SpeedFactor:        1.0, // Enemies move at base speed for now
-				SpeedEffectEndTime: time.Time{},
-				IsPlayer:           false,
-				MoveProgress:       0.0,
-				currentPath:        nil,
+				Body:          initialBody,
+				PrevBody:      prevBody,
+				Direction:     startDir,
+				PrevDirection: startDir,
+				NextDir:       startDir,
+				SpeedFactor:   1.0, // Enemies move at base speed for now
+				IsPlayer:      false,
+				MoveProgress:  0.0,
+				TargetPolicy:  policy,
+				Strategy:      strategy,
+				Color:         g.freeEnemyColor(),
+				currentPath:   nil,
 			}
 		}
 		attempts++
@@ -213,18 +580,43 @@ func (g *Game) createEnemy(occupied map[Position]bool) *Snake {
 	return nil // Failed to place enemy
 }
 
+// freeEnemyColor returns the first palette color no living enemy is using
+// (cycling through the palette if every color is taken).
+func (g *Game) freeEnemyColor() color.RGBA {
+	used := make(map[color.RGBA]bool, len(g.EnemySnakes))
+	for _, enemy := range g.EnemySnakes {
+		used[enemy.Color] = true
+	}
+	for _, clr := range EnemyPalette {
+		if !used[clr] {
+			return clr
+		}
+	}
+	return EnemyPalette[len(g.EnemySnakes)%len(EnemyPalette)]
+}
+
 // --- Food Logic ---
 
-func (g *Game) scheduleNextFoodSpawn() {
+// foodSpawnInterval returns the game time (s) between timed food spawns.
+func (g *Game) foodSpawnInterval() float64 {
 	// Add some randomness to the interval if desired
-	// interval := FoodSpawnInterval + time.Duration(rand.Intn(2000)) * time.Millisecond
-	interval := FoodSpawnInterval
-	g.nextFoodSpawnTime = time.Now().Add(interval)
+	// interval := FoodSpawnInterval + time.Duration(g.rng.Intn(2000)) * time.Millisecond
+	return FoodSpawnInterval.Seconds()
 }
 
-// scheduleNextEnemySpawn sets the time for the next enemy spawn check.
-func (g *Game) scheduleNextEnemySpawn() {
-	g.nextEnemySpawnTime = time.Now().Add(EnemySpawnInterval)
+// enemySpawnInterval returns the game time (s) between enemy spawn checks,
+// shrinking towards MinEnemySpawnInterval as the threat rises. A
+// non-positive result is treated as one second so the spawn loop in Update
+// always terminates.
+func (g *Game) enemySpawnInterval() float64 {
+	interval := g.Config.Difficulty.EnemySpawnInterval.Seconds()
+	if floor := g.Config.Difficulty.MinEnemySpawnInterval.Seconds(); floor > 0 && floor < interval {
+		interval -= (interval - floor) * g.Threat()
+	}
+	if interval > 0 {
+		return interval
+	}
+	return 1
 }
 
 // spawnFoodItem places a *single* food item randomly, avoiding obstacles.
@@ -233,9 +625,9 @@ func (g *Game) spawnFoodItem() {
 		return
 	}
 	occupied := make(map[Position]bool)
-	// Populate occupied map (include player AND enemies)
-	if g.PlayerSnake != nil {
-		for _, seg := range g.PlayerSnake.Body {
+	// Populate occupied map (include players AND enemies)
+	for _, player := range g.Players {
+		for _, seg := range player.Body {
 			occupied[seg] = true
 		}
 	}
@@ -251,46 +643,96 @@ func (g *Game) spawnFoodItem() {
 			occupied[food.Pos] = true
 		}
 	}
+	g.markObstacles(occupied)
 
 	// Determine food type based on probability (Section 5.5)
 	foodType := FoodTypeStandard // Default
 	points := 10
-	var effect func(*Snake) = nil
 	duration := 0 * time.Second
-	r := rand.Float64()
+	var lifetime time.Duration
+	r := g.rng.Float64()
 	if r < 0.15 {
 		foodType = FoodTypeSpeedUp
 	} else if r < 0.30 {
 		foodType = FoodTypeSlowDown
+	} else if r < 0.36 {
+		foodType = FoodTypeTeleport
+	} else if r < 0.44 {
+		foodType = FoodTypeShrink
+	} else if r < 0.49 {
+		foodType = FoodTypeShield
+	} else if r < 0.53 {
+		foodType = FoodTypeBomb
+	} else if r < 0.545 {
+		foodType = FoodTypeGolden
+	} else if r < 0.575 {
+		foodType = FoodTypeGhost
 	}
 	switch foodType {
 	case FoodTypeStandard:
 		points = 10
-		effect = func(s *Snake) { s.grow() }
+		if g.Config.ExpireStandardFood {
+			lifetime = standardLifetime
+		}
 	case FoodTypeSpeedUp:
 		points = 15
+		lifetime = specialLifetime
 		duration = 7 * time.Second
-		effect = func(s *Snake) { s.grow(); s.applySpeedBoost(1.5, duration) }
 	case FoodTypeSlowDown:
 		points = 5
+		lifetime = specialLifetime
 		duration = 7 * time.Second
-		effect = func(s *Snake) { s.grow(); s.applySpeedBoost(0.6, duration) }
+	case FoodTypeTeleport:
+		points = 20
+		lifetime = portalLifetime
+	case FoodTypeShrink:
+		points = 5
+		lifetime = specialLifetime
+	case FoodTypeShield:
+		points = 10
+		lifetime = specialLifetime
+	case FoodTypeBomb:
+		points = 0
+		lifetime = bombLifetime
+		g.markBombClearance(occupied)
+	case FoodTypeGolden:
+		points = goldenPoints
+		lifetime = goldenLifetime
+	case FoodTypeGhost:
+		points = 15
+		lifetime = specialLifetime
+		duration = ghostDuration
 	}
 
-	// Find an empty spot
+	// Find an empty spot, one a player can get to with ReachableFood
+	var reachable []Position
+	if g.Config.ReachableFood {
+		reachable = g.markUnreachable(occupied)
+		if len(reachable) == 0 {
+			g.checkBoardFull()
+			return
+		}
+	}
 	var newPos Position
 	attempts := 0
-	maxAttempts := GridWidth*GridHeight - len(occupied)
-	if maxAttempts <= 0 {
+	maxAttempts := g.Width*g.Height - len(occupied)
+	if maxAttempts <= 0 && reachable == nil {
 		return
 	} // No space left
 
-	for attempts < maxAttempts*2 { // Allow more attempts for sparse grids
-		newPos = Position{X: rand.Intn(GridWidth), Y: rand.Intn(GridHeight)}
-		if !occupied[newPos] {
-			break
+	if pos, ok := g.freeFoodSpawnPoint(occupied); ok && foodType != FoodTypeBomb {
+		newPos = pos // Level designer's preferred spot
+	} else if reachable != nil {
+		newPos = reachable[g.rng.Intn(len(reachable))]
+	} else {
+		for attempts < maxAttempts*2 { // Allow more attempts for sparse grids
+			zone := g.SafeZone
+			newPos = Position{X: zone.MinX + g.rng.Intn(zone.Width()), Y: zone.MinY + g.rng.Intn(zone.Height())}
+			if !occupied[newPos] {
+				break
+			}
+			attempts++
 		}
-		attempts++
 	}
 
 	if occupied[newPos] {
@@ -298,63 +740,283 @@ func (g *Game) spawnFoodItem() {
 	} // Could not find a spot
 
 	newItem := &Food{
-		Pos:      newPos,
-		Type:     foodType,
-		Points:   points,
-		Effect:   effect,
-		Duration: duration,
+		Pos:       newPos,
+		Type:      foodType,
+		Points:    points,
+		Effect:    g.foodEffect(foodType, duration),
+		Duration:  duration,
+		SpawnTime: g.GameTime,
+		Lifetime:  lifetime,
 	}
 	g.FoodItems = append(g.FoodItems, newItem)
+	if foodType == FoodTypeBomb {
+		g.invalidateObstacles() // Enemies path around bombs
+	}
+	g.emit(GameEvent{Type: EventFoodSpawned, Pos: newPos, Food: newItem})
+}
+
+// foodEffect returns what eating food of type t (with the given effect
+// duration) does to the eater, or nil for food that has no effect (bombs).
+// Effects are rebuilt from the type rather than stored, so a saved game can
+// restore them (see LoadGame).
+func (g *Game) foodEffect(t FoodType, duration time.Duration) func(*Snake) {
+	switch t {
+	case FoodTypeStandard, FoodTypeGolden:
+		return func(s *Snake) { s.grow() }
+	case FoodTypeSpeedUp:
+		return func(s *Snake) { s.grow(); g.boostSnake(s, 1.5, duration) }
+	case FoodTypeSlowDown:
+		return func(s *Snake) { s.grow(); g.boostSnake(s, 0.6, duration) }
+	case FoodTypeTeleport:
+		return func(s *Snake) { s.grow(); g.queueTeleport(s) }
+	case FoodTypeShrink:
+		return func(s *Snake) { g.shrinkSnake(s, 1+g.rng.Intn(2)) }
+	case FoodTypeShield:
+		return func(s *Snake) { s.grow(); s.ShieldCount = min(s.ShieldCount+1, MaxShields) }
+	case FoodTypeGhost:
+		return func(s *Snake) { s.grow(); s.GhostUntil = g.GameTime + duration.Seconds() }
+	}
+	return nil
+}
+
+// markBombClearance marks the cells near each player's head as taken, so a
+// bomb never appears where a player has no time to react.
+func (g *Game) markBombClearance(occupied map[Position]bool) {
+	for _, player := range g.Players {
+		if len(player.Body) == 0 {
+			continue
+		}
+		head := player.Body[0]
+		for dy := -bombClearance; dy <= bombClearance; dy++ {
+			for dx := -bombClearance; dx <= bombClearance; dx++ {
+				if abs(dx)+abs(dy) <= bombClearance {
+					occupied[Position{X: head.X + dx, Y: head.Y + dy}] = true
+				}
+			}
+		}
+	}
+}
+
+// timeLeft returns the game time (s) before the food disappears, or a
+// negative value for food that stays until eaten.
+func (f *Food) timeLeft(gameTime float64) float64 {
+	if f.Lifetime <= 0 {
+		return -1
+	}
+	return max(f.SpawnTime+f.Lifetime.Seconds()-gameTime, 0)
+}
+
+// expireFood removes food items whose lifetime has run out.
+func (g *Game) expireFood() {
+	kept := g.FoodItems[:0]
+	for _, food := range g.FoodItems {
+		if food.timeLeft(g.GameTime) == 0 {
+			if food.Type == FoodTypeBomb {
+				g.invalidateObstacles()
+			}
+			continue
+		}
+		kept = append(kept, food)
+	}
+	clear(g.FoodItems[len(kept):])
+	g.FoodItems = kept
+}
+
+// freeFoodSpawnPoint picks a random unoccupied food spawn point from the level.
+// Returns false if there is no level, it has no spawn points, or all are taken.
+func (g *Game) freeFoodSpawnPoint(occupied map[Position]bool) (Position, bool) {
+	if g.Config.Level == nil {
+		return Position{}, false
+	}
+	free := make([]Position, 0, len(g.Config.Level.FoodSpawns))
+	for _, pos := range g.Config.Level.FoodSpawns {
+		if !occupied[pos] {
+			free = append(free, pos)
+		}
+	}
+	if len(free) == 0 {
+		return Position{}, false
+	}
+	return free[g.rng.Intn(len(free))], true
+}
+
+// queueTeleport picks where a snake that just ate a portal comes out. The
+// head lands on a random free cell with a free cell ahead of it (so the jump
+// is never an instant death), and the body keeps following the old path:
+// each segment jumps across when it reaches the portal, like the head did.
+// If the board is too crowded the portal does nothing.
+func (g *Game) queueTeleport(s *Snake) {
+	occupied := make(map[Position]bool)
+	for _, snake := range append(g.Players, g.EnemySnakes...) {
+		for _, seg := range snake.Body {
+			occupied[seg] = true
+		}
+	}
+	for _, food := range g.FoodItems {
+		occupied[food.Pos] = true
+	}
+	g.markObstacles(occupied)
+
+	for attempt := 0; attempt < g.Width*g.Height; attempt++ {
+		dest := Position{X: g.rng.Intn(g.Width), Y: g.rng.Intn(g.Height)}
+		ahead := dest.step(s.Direction)
+		if occupied[dest] || occupied[ahead] || !isValid(ahead, g.Width, g.Height) {
+			continue
+		}
+		s.teleportTo = &dest
+		s.currentPath = nil // Any A* path started from the old position
+		return
+	}
+}
+
+// shrinkSnake applies shrink food to s, recording the loss for the HUD when
+// a player shrank.
+func (g *Game) shrinkSnake(s *Snake, n int) {
+	if removed := s.shrink(n); removed > 0 && s.IsPlayer {
+		g.ShrunkBy = removed
+		g.ShrinkTime = g.GameTime
+	}
+}
+
+// markObstacles adds all interior wall cells, and the cells the survival
+// walls have closed off, to the given occupancy map.
+func (g *Game) markObstacles(occupied map[Position]bool) {
+	for _, pos := range g.Obstacles {
+		occupied[pos] = true
+	}
+	g.markClosedCells(occupied)
+}
+
+// isObstacle reports whether pos is an interior wall cell or outside the safe zone.
+func (g *Game) isObstacle(pos Position) bool {
+	return g.obstacleSet[pos] || !g.SafeZone.Contains(pos)
 }
 
 // --- Snake Logic ---
 
-// grow increases snake length by duplicating the tail segment
-// Needs to update both Body and PrevBody
+// grow queues one extra segment. The snake gets longer on its next move step,
+// when advance keeps the old tail instead of dropping it.
 func (s *Snake) grow() {
-	if len(s.Body) == 0 {
-		return
+	s.pendingGrowth++
+}
+
+// advance moves the snake one cell so newHead becomes the head, consuming one
+// pending growth if any. Body and PrevBody are rebuilt together so that
+// len(PrevBody) == len(Body) and PrevBody[i] is the cell segment i moved
+// from; a newly grown tail segment starts (and stays) on the old tail cell.
+func (s *Snake) advance(newHead Position) {
+	oldBody := s.Body
+	newLen := len(oldBody)
+	if s.pendingGrowth > 0 {
+		s.pendingGrowth--
+		newLen++
 	}
-	tail := s.Body[len(s.Body)-1]
-	s.Body = append(s.Body, tail)
-	// Also append to PrevBody using the *current* last segment of PrevBody
-	if len(s.PrevBody) > 0 {
-		prevTail := s.PrevBody[len(s.PrevBody)-1]
-		s.PrevBody = append(s.PrevBody, prevTail)
-	} else {
-		// Handle edge case if PrevBody is empty but Body isn't (shouldn't happen)
-		s.PrevBody = append(s.PrevBody, tail) // Use Body's tail as fallback
+
+	body := make([]Position, newLen)
+	body[0] = newHead
+	copy(body[1:], oldBody) // Every segment takes the place of the one in front
+
+	prev := make([]Position, newLen)
+	copy(prev, oldBody)
+	if newLen > len(oldBody) {
+		prev[newLen-1] = oldBody[len(oldBody)-1] // New tail hasn't moved yet
 	}
+
+	s.Body = body
+	s.PrevBody = prev
 }
 
-// applySpeedBoost applies a temporary speed multiplier
+// shrink takes up to n segments off the snake, cancelling growth that hasn't
+// happened yet before cutting the tail, and never going below MinSnakeLen.
+// Returns how many segments were actually lost.
+func (s *Snake) shrink(n int) int {
+	removed := 0
+	for ; removed < n && s.pendingGrowth > 0; removed++ {
+		s.pendingGrowth--
+	}
+	cut := min(n-removed, len(s.Body)-MinSnakeLen)
+	if cut > 0 {
+		s.Body = s.Body[:len(s.Body)-cut]
+		s.PrevBody = s.PrevBody[:len(s.PrevBody)-cut]
+		removed += cut
+	}
+	return removed
+}
+
+// boostSnake applies a speed effect to s and reports it as an event.
+func (g *Game) boostSnake(s *Snake, factor float64, duration time.Duration) {
+	s.applySpeedBoost(factor, duration)
+	g.emit(GameEvent{Type: EventSpeedBoostStarted, Pos: headOf(s), Snake: s})
+}
+
+// applySpeedBoost applies a temporary speed multiplier (above 1 speeds the
+// snake up, below 1 slows it down) for duration of game time. Effects stack:
+//   - Another effect the same way adds its duration to the time left, up to
+//     MaxSpeedEffect, and the stronger of the two factors applies.
+//   - An effect the opposite way cancels out against the one running: the
+//     shorter duration is taken off the longer, and whichever had more time
+//     carries on with what is left. Equal durations cancel completely.
+//
+// The effect timer shown in the HUD starts over from the new time left.
 func (s *Snake) applySpeedBoost(factor float64, duration time.Duration) {
-	if s.SpeedTimer != nil {
-		s.SpeedTimer.Stop()
+	left := duration.Seconds()
+	if s.SpeedEffectLeft > 0 && s.SpeedFactor != 1 && factor != 1 {
+		if (s.SpeedFactor > 1) == (factor > 1) {
+			left += s.SpeedEffectLeft
+			if factor > 1 {
+				factor = max(factor, s.SpeedFactor)
+			} else {
+				factor = min(factor, s.SpeedFactor)
+			}
+		} else {
+			left -= s.SpeedEffectLeft
+			if left < 0 {
+				factor, left = s.SpeedFactor, -left // The running effect outlasts the new one
+			}
+		}
+	}
+	left = min(left, MaxSpeedEffect.Seconds())
+	if left <= 0 {
+		factor = 1 // Cancelled out
 	}
 	s.SpeedFactor = factor
-	endTime := time.Now().Add(duration) // Calculate end time
-	s.SpeedEffectEndTime = endTime      // Store end time
-	s.SpeedTimer = time.AfterFunc(duration, func() {
+	s.SpeedEffectLeft = left
+	s.SpeedEffectFull = left
+}
+
+// tickSpeedEffect counts the speed effect down by deltaTime of game time and
+// restores normal speed once it runs out. Only called while the game runs,
+// so effects don't expire during pauses.
+func (s *Snake) tickSpeedEffect(deltaTime float64) {
+	if s.SpeedEffectLeft <= 0 {
+		return
+	}
+	s.SpeedEffectLeft -= deltaTime
+	if s.SpeedEffectLeft <= 0 {
+		s.SpeedEffectLeft = 0
 		s.SpeedFactor = 1.0
-		s.SpeedTimer = nil
-		s.SpeedEffectEndTime = time.Time{} // Reset end time
-	})
+	}
 }
 
 // checkCollision checks if the snake's head collides with boundaries or itself
 // This is checked *only* when a move is finalized.
-func (s *Snake) checkCollision(width, height int) (hitWall bool, hitSelf bool) {
+// The boundary is the safe zone, which is the whole board outside survival mode.
+// When checkSelf is false the body is ignored and only walls are lethal.
+func (s *Snake) checkCollision(bounds Bounds, checkSelf bool) (hitWall bool, hitSelf bool) {
 	if len(s.Body) == 0 {
 		return false, false
 	}
 	head := s.Body[0]
 
 	// Check boundary collision
-	if head.X < 0 || head.X >= width || head.Y < 0 || head.Y >= height {
+	if !bounds.Contains(head) {
 		return true, false
 	}
 
+	if !checkSelf {
+		return false, false
+	}
+
 	// Check self collision (check against body segments from index 1 onwards)
 	for i := 1; i < len(s.Body); i++ {
 		if head == s.Body[i] {
@@ -365,6 +1027,12 @@ func (s *Snake) checkCollision(width, height int) (hitWall bool, hitSelf bool) {
 	return false, false
 }
 
+// Ghosting reports whether the snake is passing through snakes at gameTime.
+// A ghost still dies on walls and bombs.
+func (s *Snake) Ghosting(gameTime float64) bool {
+	return gameTime < s.GhostUntil
+}
+
 // --- Game Update Logic ---
 
 // Update proceeds the game state by one frame
@@ -373,49 +1041,128 @@ func (g *Game) Update(deltaTime float64) error {
 		return nil
 	}
 
-	// Check timed food spawning
-	if time.Now().After(g.nextFoodSpawnTime) {
+	// Advance the game clock (only while actually playing), at the time scale
+	deltaTime = g.ScaledTime(deltaTime)
+	g.GameTime += deltaTime
+	g.invalidateObstacles() // Rebuilt at most once per frame unless a snake moves
+
+	// Let the combo lapse once the window has passed without eating
+	if g.ComboCount > 0 && g.GameTime >= g.ComboExpiry {
+		g.ComboCount = 0
+	}
+
+	// Count down speed effects on game time
+	for _, player := range g.Players {
+		player.tickSpeedEffect(deltaTime)
+		player.tickStamina(deltaTime)
+	}
+	for _, enemy := range g.EnemySnakes {
+		if enemy != nil {
+			enemy.tickSpeedEffect(deltaTime)
+			g.tickHunt(enemy, deltaTime)
+		}
+	}
+
+	// Let food with a lifetime (bombs) vanish
+	g.expireFood()
+
+	// Timed food spawning. Overshoot carries over into the next interval, so
+	// spawns stay on an exact schedule however the frames are sliced.
+	g.foodSpawnTimer -= deltaTime
+	for g.foodSpawnTimer <= 0 {
 		g.spawnFoodItem()
-		g.scheduleNextFoodSpawn()
+		g.foodSpawnTimer += g.foodSpawnInterval()
 	}
 
-	// Check timed enemy spawning
-	if time.Now().After(g.nextEnemySpawnTime) {
+	// Timed enemy spawning (the check happens whether or not it succeeds)
+	g.enemySpawnTimer -= deltaTime
+	for g.enemySpawnTimer <= 0 {
 		g.spawnEnemyIfPossible()
-		g.scheduleNextEnemySpawn() // Schedule next check regardless of success
+		g.enemySpawnTimer += g.enemySpawnInterval()
 	}
 
-	// Update Player Snake Movement Progress
-	if g.PlayerSnake != nil {
-		g.updateSnakeProgress(g.PlayerSnake, deltaTime)
+	// Close in the survival walls, or run down the time attack clock
+	g.updateSafeZone(deltaTime)
+	g.updateTimeAttack(deltaTime)
+	if g.IsOver {
+		return nil
+	}
+
+	// Update Player Snake Movement Progress. In a multiplayer round a player
+	// who dies keeps their body on the board while the others finish the
+	// frame, so the last players crashing on the same step is a draw.
+	for _, player := range g.Players {
+		if player.Dead {
+			continue
+		}
+		g.updateSnakeProgress(player, deltaTime)
 		if g.IsOver {
 			return nil // Stop updates if player died this frame
 		}
 	}
 
-	// Update Enemy AI Movement Progress
-	// Iterate backwards for safe removal
-	for i := len(g.EnemySnakes) - 1; i >= 0; i-- {
-		enemy := g.EnemySnakes[i]
-		if enemy != nil {
-			g.updateEnemyAI(enemy) // Determine NextDir for enemy
-			g.updateSnakeProgress(enemy, deltaTime)
-			if g.IsOver {
-				return nil // Stop if player died colliding with this enemy
-			}
+	// Update Enemy AI Movement Progress, last enemy first. Crashes remove
+	// enemies from the list as it goes (a head-on removes both), so the loop
+	// runs over a copy and skips the dead: each enemy moves at most once.
+	enemies := append([]*Snake(nil), g.EnemySnakes...)
+	for i := len(enemies) - 1; i >= 0; i-- {
+		enemy := enemies[i]
+		if enemy == nil || enemy.Dead {
+			continue
+		}
+		g.updateEnemyAI(enemy) // Determine NextDir for enemy
+		g.updateSnakeProgress(enemy, deltaTime)
+		if g.IsOver {
+			return nil // Stop if player died colliding with this enemy
 		}
 	}
 
+	g.settleVersus()
 	return nil
 }
 
-// updateEnemyAI uses A* pathfinding to set NextDir.
+// updateEnemyAI asks the snake's strategy for NextDir, then vetoes moves
+// that would leave the snake too little room to survive. A hunting enemy
+// follows GreedyAStarStrategy, whatever its own strategy, for the hunt.
 func (g *Game) updateEnemyAI(s *Snake) {
 	if len(s.Body) == 0 {
 		return
 	}
+	strategy := s.Strategy
+	if strategy == nil || s.Hunting {
+		strategy = GreedyAStarStrategy{}
+	}
+	s.NextDir = strategy.NextDirection(g, s)
+	g.ensureEscapeRoom(s)
+}
+
+// planEnemyMove returns the next direction along (a recalculated, if need
+// be) A* path to the snake's target, or a random one when there is nothing
+// to chase. It is GreedyAStarStrategy's move.
+func (g *Game) planEnemyMove(s *Snake) Direction {
 	head := s.Body[0]
 
+	// During the opening grace period enemies just wander, whatever their policy
+	if g.InGracePeriod() {
+		return g.randomEnemyDirection(s)
+	}
+
+	// A hunter re-aims at the player's predicted position now and then, not
+	// every step, so it can be outrun
+	if s.Hunting && s.huntRetarget <= 0 {
+		s.currentPath = nil
+		s.huntRetarget = huntRetargetInterval
+	}
+
+	// A player-shadowing target moves every step, so drop paths that lead
+	// somewhere the player no longer is.
+	if s.TargetPolicy == TargetShadowPlayer && !s.Hunting && len(s.currentPath) > 0 {
+		target, ok := g.selectTarget(s)
+		if !ok || s.currentPath[len(s.currentPath)-1] != target {
+			s.currentPath = nil
+		}
+	}
+
 	// --- Path Following ---
 	if len(s.currentPath) > 0 {
 		// Check if the next step in the path is the current head position
@@ -431,22 +1178,18 @@ func (g *Game) updateEnemyAI(s *Snake) {
 		// Set NextDir based on the first step in the existing path
 		nextStep := s.currentPath[0]
 		newDir := directionFromTo(head, nextStep)
+		if g.wrapped(head.step(newDir)) != nextStep {
+			// The snake is off its path (a teleport moved it): following
+			// the path's general direction could lead anywhere
+			s.currentPath = nil
+			goto recalculate
+		}
 		if newDir != DirNone {
 			// Basic check: don't immediately reverse into self
 			canMove := true
 			if len(s.Body) > 1 {
 				neck := s.Body[1]
-				potentialNextHead := head
-				switch newDir {
-				case DirUp:
-					potentialNextHead.Y--
-				case DirDown:
-					potentialNextHead.Y++
-				case DirLeft:
-					potentialNextHead.X--
-				case DirRight:
-					potentialNextHead.X++
-				}
+				potentialNextHead := g.wrapped(head.step(newDir))
 				if potentialNextHead == neck {
 					canMove = false
 					// log.Printf("AI %p avoiding neck collision by recalculating", s)
@@ -455,157 +1198,338 @@ func (g *Game) updateEnemyAI(s *Snake) {
 				}
 			}
 			if canMove {
-				s.NextDir = newDir
-				return // Successfully following path
+				return newDir // Successfully following path
 			}
 		}
 	}
 
 recalculate: // Label for jumping to path recalculation
 	// --- Path Recalculation ---
-	targetFood := g.findClosestFood(head)
-	if targetFood == nil {
-		g.setRandomEnemyDirection(s) // No food, move randomly
-		return
+	target, ok := g.selectTarget(s)
+	if !ok {
+		return g.randomEnemyDirection(s) // Nothing to chase, move randomly
 	}
 
 	// Build obstacle map
-	obstacles := g.buildObstacleMap(s) // Exclude self head
+	obstacles := g.obstaclesFor(s) // Exclude self head
 
-	// Find path
-	path := findPath(head, targetFood.Pos, GridWidth, GridHeight, obstacles)
+	// Find path; cautious snakes pay extra for squeezing past obstacles
+	var extra stepCost
+	if s.TargetPolicy == TargetCautious {
+		extra = clearanceCost(obstacles, cautiousClearancePenalty)
+	}
+	path := findPath(head, target, g.Width, g.Height, obstacles, extra)
 
-	if path != nil && len(path) > 0 {
+	if len(path) > 0 {
 		s.currentPath = path
 		// Set direction based on the first step
 		newDir := directionFromTo(head, path[0])
 		if newDir != DirNone {
-			s.NextDir = newDir
-		} else {
-			// Should not happen if path is valid
-			log.Printf("Warning: A* path resulted in invalid first step for AI %p", s)
-			g.setRandomEnemyDirection(s) // Fallback
+			return newDir
+		}
+		// Should not happen if path is valid
+		log.Printf("Warning: A* path resulted in invalid first step for AI %p", s)
+		return g.randomEnemyDirection(s) // Fallback
+	}
+	// No path found (target unreachable or blocked, nil path), or the head
+	// is already on the target (empty path): there is no step to take
+	// towards it, so wander rather than stall on a path with no steps
+	// log.Printf("AI %p could not find path to target at %v", s, target)
+	return g.randomEnemyDirection(s) // Fallback: Move randomly but avoid obstacles
+}
+
+// selectTarget picks the cell an enemy should path towards according to its
+// TargetPolicy. Returns false if there is nothing suitable to chase.
+func (g *Game) selectTarget(s *Snake) (Position, bool) {
+	if len(s.Body) == 0 {
+		return Position{}, false
+	}
+	head := s.Body[0]
+
+	if s.Hunting {
+		if pos, ok := g.huntTarget(head); ok {
+			return pos, true
 		}
-	} else {
-		// No path found (food unreachable or blocked)
-		// log.Printf("AI %p could not find path to food at %v", s, targetFood.Pos)
-		g.setRandomEnemyDirection(s) // Fallback: Move randomly but avoid obstacles
 	}
+
+	switch s.TargetPolicy {
+	case TargetFoodAwayFromPlayer:
+		if food := g.findFoodAwayFromPlayer(head); food != nil {
+			return food.Pos, true
+		}
+	case TargetShadowPlayer:
+		if pos, ok := g.findCellNextToPlayer(head); ok {
+			return pos, true
+		}
+		// Player unreachable (or absent): behave like a food seeker
+		if food := g.findClosestFood(head); food != nil {
+			return food.Pos, true
+		}
+	default:
+		if food := g.findClosestFood(head); food != nil {
+			return food.Pos, true
+		}
+	}
+	return Position{}, false
 }
 
-// findClosestFood finds the nearest food item to a given position.
+// findFoodAwayFromPlayer finds the nearest food to pos, penalising food that
+// lies within playerAvoidRadius of a living player's head.
+func (g *Game) findFoodAwayFromPlayer(pos Position) *Food {
+	heads := g.livingPlayerHeads()
+	if len(heads) == 0 {
+		return g.findClosestFood(pos)
+	}
+
+	var best *Food
+	bestScore := 0
+	for _, food := range g.FoodItems {
+		if food == nil || food.Type == FoodTypeBomb {
+			continue
+		}
+		score := heuristic(pos, food.Pos)
+		for _, head := range heads {
+			if playerDist := heuristic(head, food.Pos); playerDist < playerAvoidRadius {
+				score += (playerAvoidRadius - playerDist) * 2
+			}
+		}
+		if best == nil || score < bestScore {
+			bestScore = score
+			best = food
+		}
+	}
+	return best
+}
+
+// findCellNextToPlayer returns the free cell adjacent to a living player's
+// head that is closest to pos, so an enemy shadows whichever player is nearest.
+func (g *Game) findCellNextToPlayer(pos Position) (Position, bool) {
+	obstacles := g.obstaclesFor(nil)
+
+	var best Position
+	found := false
+	for _, playerHead := range g.livingPlayerHeads() {
+		for _, offset := range []Position{{X: 0, Y: -1}, {X: 0, Y: 1}, {X: -1, Y: 0}, {X: 1, Y: 0}} {
+			cell := Position{X: playerHead.X + offset.X, Y: playerHead.Y + offset.Y}
+			if !isValid(cell, g.Width, g.Height) || obstacles.blocked(cell) {
+				continue
+			}
+			if !found || heuristic(pos, cell) < heuristic(pos, best) {
+				best = cell
+				found = true
+			}
+		}
+	}
+	return best, found
+}
+
+// findClosestFood finds the food item worth the most points per step from
+// a given position, scoring each by points / (distance + 1), so a slightly
+// farther speed-up or golden apple can beat plain food next door. Between
+// equally scored items (e.g. all standard food) the nearest wins. Golden
+// apples get no priority beyond their points.
 func (g *Game) findClosestFood(pos Position) *Food {
-	var closestFood *Food = nil
-	minDist := -1
+	var best *Food
+	bestPoints, bestDist := 0, 0
 
 	for _, food := range g.FoodItems {
-		if food == nil {
+		if food == nil || food.Type == FoodTypeBomb {
 			continue
 		}
+		points := max(food.Points, 1)    // Zero-point food is still worth eating
 		dist := heuristic(pos, food.Pos) // Manhattan distance
-		if closestFood == nil || dist < minDist {
-			minDist = dist
-			closestFood = food
+		// points/(dist+1) > bestPoints/(bestDist+1), without dividing
+		better := points*(bestDist+1) - bestPoints*(dist+1)
+		if best == nil || better > 0 || (better == 0 && dist < bestDist) {
+			best, bestPoints, bestDist = food, points, dist
 		}
 	}
-	return closestFood
+	return best
 }
 
-// buildObstacleMap creates a map of all occupied cells for pathfinding.
-// Includes wall padding and all snake segments except the head of the snake `self`.
-func (g *Game) buildObstacleMap(self *Snake) map[Position]bool {
+// nearestFood returns the food nearest pos by Manhattan distance, ignoring
+// its value (unlike findClosestFood) and bombs, or nil if there is none.
+func (g *Game) nearestFood(pos Position) *Food {
+	var best *Food
+	bestDist := 0
+	for _, food := range g.FoodItems {
+		if food == nil || food.Type == FoodTypeBomb {
+			continue
+		}
+		if dist := heuristic(pos, food.Pos); best == nil || dist < bestDist {
+			best, bestDist = food, dist
+		}
+	}
+	return best
+}
+
+// buildObstacleMap creates a map of all occupied cells for pathfinding:
+// every snake segment, heads included, plus the walls. Use obstaclesFor,
+// which shares one map between all snakes, rather than calling it directly.
+func (g *Game) buildObstacleMap() map[Position]bool {
 	obstacles := make(map[Position]bool)
 
-	// Player Snake Body (Include head now for avoidance)
-	if g.PlayerSnake != nil {
-		// for i, seg := range g.PlayerSnake.Body {
+	// Player Snake Bodies (Include head now for avoidance)
+	for _, player := range g.Players {
+		// for i, seg := range player.Body {
 		// 	if i > 0 { // Skip player head
 		// 		obstacles[seg] = true
 		// 	}
 		// }
-		for _, seg := range g.PlayerSnake.Body {
+		for _, seg := range player.Body {
 			obstacles[seg] = true // Include player head as obstacle
 		}
 	}
 
-	// Other Enemy Snakes (include head and body)
-	for _, enemy := range g.EnemySnakes {
-		if enemy != nil && enemy != self {
-			for _, seg := range enemy.Body {
-				obstacles[seg] = true
-			}
+	// Bombs are as deadly as a body
+	for _, food := range g.FoodItems {
+		if food.Type == FoodTypeBomb {
+			obstacles[food.Pos] = true
 		}
 	}
 
-	// Self Body (excluding head)
-	if self != nil {
-		for i, seg := range self.Body {
-			if i > 0 { // Still exclude self head
+	// Enemy Snakes (include head and body; obstaclesFor frees a snake's own head)
+	for _, enemy := range g.EnemySnakes {
+		if enemy != nil {
+			for _, seg := range enemy.Body {
 				obstacles[seg] = true
 			}
 		}
 	}
 
-	// TODO: Add walls as obstacles explicitly if needed for A*?
-	// Currently relies on isValid check, might be slightly less efficient.
+	// Interior walls (board edges are handled by isValid)
+	g.markObstacles(obstacles)
 
 	return obstacles
 }
 
-// setRandomEnemyDirection chooses a valid random direction, avoiding immediate obstacles.
-func (g *Game) setRandomEnemyDirection(s *Snake) {
-	head := s.Body[0]
-	possibleDirs := []Direction{DirUp, DirDown, DirLeft, DirRight}
-	validDirs := []Direction{}
+// randomEnemyDirection chooses a valid random direction, avoiding immediate
+// obstacles, and drops the snake's path.
+func (g *Game) randomEnemyDirection(s *Snake) Direction {
+	s.currentPath = nil            // Clear path as we are moving randomly
+	obstacles := g.obstaclesFor(s) // Need current obstacles
+	if validDirs := g.freeDirections(s, obstacles); len(validDirs) > 0 {
+		return validDirs[g.rng.Intn(len(validDirs))]
+	}
+	// Nowhere obviously safe to go: panic and pick the move with the most room
+	return g.panicDirection(s, obstacles)
+}
 
-	obstacles := g.buildObstacleMap(s) // Need current obstacles
+// freeDirections returns the moves, in up, down, left, right order, that
+// don't reverse the snake and lead onto a free cell.
+func (g *Game) freeDirections(s *Snake, obstacles obstacleView) []Direction {
+	var free []Direction
+	for _, dir := range []Direction{DirUp, DirDown, DirLeft, DirRight} {
+		if isOpposite(dir, s.Direction) {
+			continue // Prevent immediate reversal
+		}
+		// Check if the next cell is valid and not an obstacle
+		nextPos := obstacles.step(s.Body[0], dir)
+		if isValid(nextPos, g.Width, g.Height) && !obstacles.blocked(nextPos) {
+			free = append(free, dir)
+		}
+	}
+	return free
+}
 
-	for _, dir := range possibleDirs {
-		// Prevent immediate reversal
-		if (dir == DirUp && s.Direction == DirDown) || (dir == DirDown && s.Direction == DirUp) ||
-			(dir == DirLeft && s.Direction == DirRight) || (dir == DirRight && s.Direction == DirLeft) {
-			continue
+// ensureEscapeRoom is the space heuristic gating the planner: if the planned
+// move leads somewhere with fewer reachable cells than the snake is long
+// (a dead end it can't turn around in), it switches to the roomiest move
+// instead and drops the path so A* replans from there next step. Cautious
+// enemies want cautiousRoomFactor times that much room.
+func (g *Game) ensureEscapeRoom(s *Snake) {
+	room := g.withoutTailTips(g.obstaclesFor(s)).blocking(s.Body[0]) // The head is the neck after the move
+	need := len(s.Body)
+	if s.TargetPolicy == TargetCautious {
+		need *= cautiousRoomFactor // Steers clear of anything remotely tight
+	}
+	if floodFillCount(room.step(s.Body[0], s.NextDir), g.Width, g.Height, room, need) >= need {
+		return // Enough space ahead
+	}
+	if dir := roomiestDirection(s, room, g.Width, g.Height); dir != s.NextDir {
+		s.NextDir = dir
+		s.currentPath = nil
+	}
+}
+
+// withoutTailTips returns obstacles with every snake's tail tip freed,
+// since tails vacate their cell on the next step.
+func (g *Game) withoutTailTips(obstacles obstacleView) obstacleView {
+	var tails []Position
+	for _, other := range append(g.Players, g.EnemySnakes...) {
+		if other != nil && len(other.Body) > 1 {
+			tails = append(tails, other.Body[len(other.Body)-1])
 		}
+	}
+	return obstacles.without(tails...)
+}
 
-		// Check if the next cell is valid and not an obstacle
-		nextPos := head
-		switch dir {
-		case DirUp:
-			nextPos.Y--
-		case DirDown:
-			nextPos.Y++
-		case DirLeft:
-			nextPos.X--
-		case DirRight:
-			nextPos.X++
+// panicDirection is the last resort for a trapped enemy. Tail tips vacate
+// their cell on the next step, so they are treated as free; each remaining
+// candidate cell is then scored by the amount of space reachable from it and
+// the roomiest one wins. Falls back to the current direction if every move
+// is immediately lethal.
+func (g *Game) panicDirection(s *Snake, obstacles obstacleView) Direction {
+	return roomiestDirection(s, g.withoutTailTips(obstacles), g.Width, g.Height)
+}
+
+// roomiestDirection returns the non-reversing move from which the most cells
+// are reachable, or the current direction if none has any room. The head is
+// the neck once the snake has moved, so no region is counted through it.
+func roomiestDirection(s *Snake, obstacles obstacleView, width, height int) Direction {
+	head := s.Body[0]
+	obstacles = obstacles.blocking(head)
+	bestDir := s.Direction
+	bestSpace := 0
+	for _, dir := range []Direction{DirUp, DirDown, DirLeft, DirRight} {
+		if isOpposite(dir, s.Direction) {
+			continue
 		}
-		if isValid(nextPos, GridWidth, GridHeight) && !obstacles[nextPos] {
-			validDirs = append(validDirs, dir)
+		space := floodFillCount(obstacles.step(head, dir), width, height, obstacles, 0)
+		if space > bestSpace {
+			bestSpace = space
+			bestDir = dir
 		}
 	}
+	return bestDir
+}
 
-	if len(validDirs) > 0 {
-		s.NextDir = validDirs[rand.Intn(len(validDirs))]
-	} else {
-		// Nowhere to go? Keep current direction (will likely collide)
-		s.NextDir = s.Direction
-		// log.Printf("AI %p trapped! No valid random move.", s)
+// isOpposite reports whether a and b point in opposite directions.
+func isOpposite(a, b Direction) bool {
+	switch a {
+	case DirUp:
+		return b == DirDown
+	case DirDown:
+		return b == DirUp
+	case DirLeft:
+		return b == DirRight
+	case DirRight:
+		return b == DirLeft
 	}
-	s.currentPath = nil // Clear path as we are moving randomly
+	return false
 }
 
 // directionFromTo calculates the direction needed to move from pos 'from' to pos 'to'.
+// A step of more than one cell is a wrap through the board's edge, which
+// is taken by heading the other way.
 func directionFromTo(from, to Position) Direction {
-	if to.Y < from.Y {
+	dx, dy := to.X-from.X, to.Y-from.Y
+	if abs(dx) > 1 {
+		dx = -dx
+	}
+	if abs(dy) > 1 {
+		dy = -dy
+	}
+	if dy < 0 {
 		return DirUp
 	}
-	if to.Y > from.Y {
+	if dy > 0 {
 		return DirDown
 	}
-	if to.X < from.X {
+	if dx < 0 {
 		return DirLeft
 	}
-	if to.X > from.X {
+	if dx > 0 {
 		return DirRight
 	}
 	return DirNone // Should not happen for adjacent cells
@@ -617,8 +1541,13 @@ func (g *Game) updateSnakeProgress(s *Snake, deltaTime float64) {
 		return
 	}
 
-	// Calculate movement amount for this frame
-	moveAmount := s.SpeedFactor * g.Speed * deltaTime
+	// Calculate movement amount for this frame. Speed food scales the
+	// score-based speed, so a boost still counts once the base reaches
+	// MaxSpeed; only the base is capped.
+	moveAmount := s.speedMultiplier() * g.Speed * deltaTime
+	if !s.IsPlayer {
+		moveAmount *= g.enemySpeedScale() // Enemies speed up as the threat rises
+	}
 	s.MoveProgress += moveAmount
 
 	// Did the snake complete one or more grid moves this frame?
@@ -631,146 +1560,177 @@ func (g *Game) updateSnakeProgress(s *Snake, deltaTime float64) {
 		}
 
 		// 1. Finalize the move for this step
-		// Store current body as previous body (needs a deep copy)
-		s.PrevBody = make([]Position, len(s.Body))
-		copy(s.PrevBody, s.Body)
-
-		// Determine actual direction for this step
+		// Determine actual direction for this step, taking the next queued turn
+		s.NextDir = s.nextQueuedDir()
+		s.PrevDirection = s.Direction
 		s.Direction = s.NextDir
 
-		// Calculate next head position
-		head := s.Body[0]
-		newHead := head
-		switch s.Direction {
-		case DirUp:
-			newHead.Y--
-		case DirDown:
-			newHead.Y++
-		case DirLeft:
-			newHead.X--
-		case DirRight:
-			newHead.X++
-		}
+		// Calculate next head position, coming back in through a wrapping edge
+		newHead := g.wrapped(s.Body[0].step(s.Direction))
 
 		// Check for food at the *target* position *before* updating body
 		ateFoodIndex := -1
+		var bomb *Food
 		for i, food := range g.FoodItems {
 			if food != nil && newHead == food.Pos {
 				ateFoodIndex = i
+				if food.Type == FoodTypeBomb {
+					bomb = food // Not eaten: it goes off (see below)
+					break
+				}
 				if s.IsPlayer {
-					g.Score += food.Points
+					g.awardPoints(s, food)
 				}
 				if food.Effect != nil {
 					food.Effect(s) // Apply effect (which might call s.grow())
 				}
-				// Immediately try to spawn replacement
-				g.spawnFoodItem()
+				// Immediately try to spawn replacement (unless playing a scarce-food mode)
+				if g.Config.RespawnFoodOnEat {
+					g.spawnFoodItem()
+				}
 
 				// Trigger food eaten effect
-				pos := food.Pos // Copy position
-				if s.IsPlayer {
-					g.FoodEatenPos = &pos
-					g.FoodEatenTime = time.Now()
-				} else {
-					g.EnemyFoodEatenPos = &pos // Set enemy signal
+				g.emit(GameEvent{Type: EventFoodEaten, Pos: food.Pos, Snake: s, Food: food})
+				if s.IsPlayer && g.OnPlayerEat != nil {
+					g.OnPlayerEat(food)
 				}
 
 				break
 			}
 		}
 
-		// Update body: Prepend new head, potentially grow
+		// A portal sends the head elsewhere instead of onto the food cell
+		if s.teleportTo != nil {
+			newHead = *s.teleportTo
+			s.teleportTo = nil
+		}
+
+		// Update body: prepend new head, growing if food.Effect() queued it
+		oldBody, oldGrowth := s.Body, s.pendingGrowth // For undoing the step if a shield absorbs a crash
+		s.advance(newHead)
 		if ateFoodIndex != -1 {
-			// Body grew inside food.Effect(), just prepend new head
-			// Need to ensure grow() updated both Body and PrevBody correctly
-			newBody := make([]Position, len(s.Body))
-			newBody[0] = newHead
-			copy(newBody[1:], s.Body[:len(s.Body)-1]) // Shift old body
-			s.Body = newBody
-
-			// Remove eaten food *after* potential growth
 			g.FoodItems = append(g.FoodItems[:ateFoodIndex], g.FoodItems[ateFoodIndex+1:]...)
-		} else {
-			// No food eaten, normal move: Prepend new head, drop tail
-			newBody := make([]Position, len(s.Body))
-			newBody[0] = newHead
-			copy(newBody[1:], s.Body[:len(s.Body)-1])
-			s.Body = newBody
+		}
+		g.invalidateObstacles()
+
+		// A bomb is lethal whatever the snake is carrying
+		if bomb != nil {
+			g.emit(GameEvent{Type: EventBombExploded, Pos: bomb.Pos, Snake: s, Food: bomb})
+			if s.IsPlayer {
+				g.killPlayer(s, "Player Hit a Bomb")
+			} else {
+				g.removeEnemySnake(s)
+			}
+			return
+		}
+
+		if s == g.player(1) {
+			g.StepCount++
 		}
 
 		// 2. Check Collisions (only after finalizing position)
-		hitWall, hitSelf := s.checkCollision(GridWidth, GridHeight)
+		checkSelf := !(s.IsPlayer && (g.Config.NoSelfCollision || g.Practice())) && !s.Ghosting(g.GameTime)
+		hitWall, hitSelf := s.checkCollision(g.SafeZone, checkSelf)
+		if g.isObstacle(s.Body[0]) {
+			hitWall = true // Interior walls are as deadly as the border
+		}
+		if (hitWall || hitSelf) && (s.ShieldCount > 0 || (s.IsPlayer && g.Practice())) {
+			// The shield takes the hit (in practice it's free): undo the step and bounce off
+			if !g.Practice() {
+				s.ShieldCount--
+			}
+			s.Body = oldBody
+			s.PrevBody = append([]Position(nil), oldBody...)
+			s.pendingGrowth = oldGrowth
+			g.invalidateObstacles()
+			s.Direction = roomiestDirection(s, g.obstaclesFor(s), g.Width, g.Height)
+			s.PrevDirection = s.Direction // Snap round rather than spin through the wall
+			s.NextDir = s.Direction
+			s.inputQueue = nil // Queued turns were meant for the path before the bounce
+			s.currentPath = nil
+			continue
+		}
 		if hitWall || hitSelf {
 			if s.IsPlayer {
-				g.triggerGameOver("Player Self/Wall Collision")
+				g.killPlayer(s, "Player Self/Wall Collision")
 			} else {
 				g.removeEnemySnake(s) // Remove enemy on collision
 			}
 			return // Stop processing this snake if it died
 		}
 
-		// Check Inter-snake Collisions
-		if g.checkInterSnakeCollisions(s) {
-			// If this snake died or caused player game over, stop processing it.
-			if g.IsOver || !g.isSnakeAlive(s) {
-				return
-			}
-		}
-	}
-}
-
-// isSnakeAlive checks if a given snake pointer still exists in the EnemySnakes slice.
-// Used after collision checks to see if the snake was removed.
-func (g *Game) isSnakeAlive(snake *Snake) bool {
-	if snake.IsPlayer {
-		return true // Player handled by g.IsOver
-	}
-	for _, enemy := range g.EnemySnakes {
-		if enemy == snake {
-			return true
+		// Check Inter-snake Collisions. A crash that didn't kill this snake
+		// (in practice, where nothing is fatal) lets it carry on.
+		if g.checkInterSnakeCollisions(s) && (g.IsOver || s.Dead) {
+			return
 		}
 	}
-	return false
 }
 
 // checkInterSnakeCollisions checks collisions between the given snake `s` and all other snakes.
-// Returns true if a collision occurred that requires stopping processing for `s`.
+// Returns true if `s` crashed; both snakes in a crash are dealt with here, once.
+// A ghosting snake passes through other snakes and they pass through it.
 func (g *Game) checkInterSnakeCollisions(s *Snake) bool {
-	if len(s.Body) == 0 {
+	if len(s.Body) == 0 || s.Ghosting(g.GameTime) {
 		return false
 	}
 	head := s.Body[0]
 
-	// Check against player if `s` is an enemy
-	if !s.IsPlayer && g.PlayerSnake != nil && len(g.PlayerSnake.Body) > 0 {
-		playerHead := g.PlayerSnake.Body[0]
-		// Head-on check
-		if head == playerHead {
-			g.triggerGameOver("Enemy Head-on Collision")
-			g.removeEnemySnake(s)
-			return true // Player game over, stop processing enemy
-		}
-		// Check if enemy head hit player body
-		for i := 1; i < len(g.PlayerSnake.Body); i++ {
-			if head == g.PlayerSnake.Body[i] {
+	// Check against the players if `s` is an enemy
+	if !s.IsPlayer {
+		for _, player := range g.Players {
+			if len(player.Body) == 0 || player.Ghosting(g.GameTime) {
+				continue
+			}
+			// Head-on check
+			if head == player.Body[0] {
+				g.killPlayer(player, "Enemy Head-on Collision")
 				g.removeEnemySnake(s)
-				// TODO: Award points?
-				return true // Enemy died, stop processing it
+				return true // Player game over, stop processing enemy
+			}
+			// Check if enemy head hit player body
+			for i := 1; i < len(player.Body); i++ {
+				if head == player.Body[i] {
+					g.removeEnemySnake(s)
+					// TODO: Award points?
+					return true // Enemy died, stop processing it
+				}
+			}
+		}
+	}
+
+	// Check against the other players if `s` is a player
+	if s.IsPlayer {
+		for _, other := range g.Players {
+			if other == s || len(other.Body) == 0 || other.Ghosting(g.GameTime) {
+				continue
+			}
+			// Head-on: neither player wins
+			if head == other.Body[0] {
+				g.killPlayer(s, "Players Head-on Collision")
+				g.killPlayer(other, "Players Head-on Collision")
+				return true
+			}
+			for i := 1; i < len(other.Body); i++ {
+				if head == other.Body[i] {
+					g.killPlayer(s, "Player Hit Other Player")
+					return true
+				}
 			}
 		}
 	}
 
 	// Check against enemies
 	for _, other := range g.EnemySnakes {
-		if s == other || other == nil || len(other.Body) == 0 {
-			continue // Skip self and dead enemies
+		if s == other || other == nil || len(other.Body) == 0 || other.Ghosting(g.GameTime) {
+			continue // Skip self, dead and ghosting enemies
 		}
 		otherHead := other.Body[0]
 
 		// Head-on check (Enemy vs Enemy or Player vs Enemy)
 		if head == otherHead {
 			if s.IsPlayer {
-				g.triggerGameOver("Player Head-on Collision")
+				g.killPlayer(s, "Player Head-on Collision")
 				g.removeEnemySnake(other)
 				return true // Player game over
 			} else {
@@ -785,7 +1745,7 @@ func (g *Game) checkInterSnakeCollisions(s *Snake) bool {
 		for i := 1; i < len(other.Body); i++ {
 			if head == other.Body[i] {
 				if s.IsPlayer {
-					g.triggerGameOver("Player Hit Enemy Body")
+					g.killPlayer(s, "Player Hit Enemy Body")
 					return true // Player game over
 				} else {
 					// Enemy hit another enemy's body
@@ -798,137 +1758,369 @@ func (g *Game) checkInterSnakeCollisions(s *Snake) bool {
 	return false // No relevant collision found for `s`
 }
 
-// removeEnemySnake removes a specific enemy snake from the game slice.
+// removeEnemySnake removes a specific enemy snake from the game slice and
+// marks it dead, so a loop still holding it knows to skip it. Removing it
+// again does nothing.
 func (g *Game) removeEnemySnake(snakeToRemove *Snake) {
 	newEnemyList := g.EnemySnakes[:0]
 	for _, s := range g.EnemySnakes {
 		if s != snakeToRemove {
 			newEnemyList = append(newEnemyList, s)
 		} else {
+			s.Dead = true
 			log.Printf("Enemy snake removed due to collision.")
-			// TODO: Trigger enemy death effect/sound
+			g.emit(GameEvent{Type: EventEnemyDied, Pos: headOf(s), Snake: s})
+			if g.OnEnemyDeath != nil {
+				g.OnEnemyDeath(s)
+			}
 		}
 	}
+	removed := len(newEnemyList) < len(g.EnemySnakes)
 	g.EnemySnakes = newEnemyList
+	g.invalidateObstacles()
+	if removed {
+		g.dropFood(snakeToRemove)
+	}
+}
+
+// dropFood leaves standard food on some of a dead enemy's body cells (every
+// deathDropSpacing segments, at most maxDeathDrops), rewarding the player for
+// causing enemy crashes. Cells taken by another snake, food or a wall are
+// skipped, and MaxTotalFoodItems still applies.
+func (g *Game) dropFood(dead *Snake) {
+	occupied := make(map[Position]bool)
+	for _, player := range g.Players {
+		for _, seg := range player.Body {
+			occupied[seg] = true
+		}
+	}
+	for _, enemy := range g.EnemySnakes {
+		for _, seg := range enemy.Body {
+			occupied[seg] = true
+		}
+	}
+	for _, food := range g.FoodItems {
+		occupied[food.Pos] = true
+	}
+	g.markObstacles(occupied)
+
+	dropped := 0
+	for i := 0; i < len(dead.Body) && dropped < maxDeathDrops; i += deathDropSpacing {
+		pos := dead.Body[i]
+		if len(g.FoodItems) >= MaxTotalFoodItems {
+			return
+		}
+		if occupied[pos] || !isValid(pos, g.Width, g.Height) {
+			continue
+		}
+		occupied[pos] = true
+		food := &Food{
+			Pos:       pos,
+			Type:      FoodTypeStandard,
+			Points:    10,
+			Effect:    g.foodEffect(FoodTypeStandard, 0),
+			SpawnTime: g.GameTime,
+		}
+		if g.Config.ExpireStandardFood {
+			food.Lifetime = standardLifetime
+		}
+		g.FoodItems = append(g.FoodItems, food)
+		g.emit(GameEvent{Type: EventFoodSpawned, Pos: pos, Food: food})
+		dropped++
+	}
+}
+
+// awardPoints credits a food eaten by a player. In single player the combo
+// multiplier applies; in a multiplayer round each player banks the plain points.
+func (g *Game) awardPoints(s *Snake, food *Food) {
+	i := slices.Index(g.Players, s)
+	if i < 0 {
+		return
+	}
+	defer g.updateSpeed() // Every bite speeds the game up
+	if len(g.Players) > 1 {
+		g.Scores[i] += food.Points
+		return
+	}
+	g.extendCombo()
+	g.Scores[i] += food.Points * g.ComboMultiplier()
+}
+
+// extendCombo counts a food eaten by the player towards the combo: within
+// ComboWindow of the previous one it grows, otherwise it starts again at 1.
+func (g *Game) extendCombo() {
+	if g.ComboCount > 0 && g.GameTime < g.ComboExpiry {
+		g.ComboCount++
+	} else {
+		g.ComboCount = 1
+	}
+	g.ComboExpiry = g.GameTime + ComboWindow.Seconds()
+}
+
+// ComboMultiplier returns the current point multiplier (1 when no combo is running).
+func (g *Game) ComboMultiplier() int {
+	return max(g.ComboCount, 1)
+}
+
+// killPlayer marks a player snake as dead. With one player that ends the
+// game at once; in a multiplayer round the end is settled after the frame
+// (see settleVersus) so the other players still get to move. In practice
+// mode the player survives, and in time attack they start over for a time
+// penalty (see crashInTimeAttack).
+func (g *Game) killPlayer(s *Snake, reason string) {
+	if g.Practice() {
+		return // Nothing is fatal in practice
+	}
+	if g.TimeAttack() {
+		if !s.Dead {
+			g.crashInTimeAttack(s)
+		}
+		return
+	}
+	if !s.Dead {
+		g.emit(GameEvent{Type: EventPlayerDied, Pos: headOf(s), Snake: s, Body: slices.Clone(s.Body)})
+	}
+	s.Dead = true
+	if len(g.Players) < 2 {
+		g.triggerGameOver(reason)
+	}
+}
+
+// settleVersus ends a multiplayer round once at most one player is left
+// alive: that player wins, and if the last ones all died on the same frame
+// the round is a draw.
+func (g *Game) settleVersus() {
+	if len(g.Players) < 2 || g.IsOver {
+		return
+	}
+	alive, winner := 0, 0
+	for i, player := range g.Players {
+		if !player.Dead {
+			alive++
+			winner = i + 1
+		}
+	}
+	if alive > 1 {
+		return
+	}
+	g.Winner = winner // 0 when nobody is left
+	g.triggerGameOver("Multiplayer round decided")
 }
 
 // triggerGameOver sets the game over state
 func (g *Game) triggerGameOver(reason string) {
 	// TODO: Add reason handling if needed
+	wasOver := g.IsOver
 	g.IsOver = true
-	if g.PlayerSnake != nil && g.PlayerSnake.SpeedTimer != nil {
-		g.PlayerSnake.SpeedTimer.Stop()
+	if !wasOver {
+		g.awardLengthBonus()
+		g.emit(GameEvent{Type: EventGameOver})
+	}
+	if !wasOver && g.OnGameOver != nil {
+		g.OnGameOver()
 	}
-	// TODO: Play Game Over sound
 }
 
-// TogglePause pauses or resumes the game
+// awardLengthBonus adds LengthBonusPoints per segment of the player's snake
+// to the score when a single-player round ends. Multiplayer rounds are decided
+// by who survives, so their scores stay food-only.
+func (g *Game) awardLengthBonus() {
+	if len(g.Players) != 1 {
+		return
+	}
+	g.LengthBonus = len(g.Players[0].Body) * LengthBonusPoints
+	g.Scores[0] += g.LengthBonus
+}
+
+// TogglePause pauses or resumes the game. All timers (spawns, grace period,
+// speed effects) run on game time, which Update stops advancing while paused,
+// and the snakes keep their MoveProgress; so nothing needs freezing or
+// restoring here, and a round resumes exactly where it stopped.
 func (g *Game) TogglePause() {
 	g.IsPaused = !g.IsPaused
-	// TODO: Adjust ticker/timers when pausing/resuming
-	if g.IsPaused {
-		if g.PlayerSnake != nil && g.PlayerSnake.SpeedTimer != nil {
-			g.PlayerSnake.SpeedTimer.Stop()
-		}
-		// TODO: Pause SpeedTimers if implemented precisely
-	} else {
-		// Resume: Reset ticker based on current speed
-		if g.PlayerSnake != nil && g.PlayerSnake.SpeedTimer != nil {
-			g.PlayerSnake.SpeedTimer.Reset(time.Second / time.Duration(g.Speed*g.PlayerSnake.SpeedFactor))
-		}
-		// TODO: Resume SpeedTimers
-	}
 }
 
-// HandleInput updates the player's next direction based on input
+// HandleInput updates player 1's next direction based on input
 func (g *Game) HandleInput(newDir Direction) {
-	// Prevent immediate reversal
-	currentDir := g.PlayerSnake.Direction
-	isValidMove := true
-	switch newDir {
-	case DirUp:
-		if currentDir == DirDown {
-			isValidMove = false
-		}
-	case DirDown:
-		if currentDir == DirUp {
-			isValidMove = false
-		}
-	case DirLeft:
-		if currentDir == DirRight {
-			isValidMove = false
-		}
-	case DirRight:
-		if currentDir == DirLeft {
-			isValidMove = false
-		}
+	g.HandlePlayerInput(1, newDir)
+}
+
+// HandlePlayerInput updates the next direction of player n (1-based). It
+// does nothing for a player who isn't in the round.
+func (g *Game) HandlePlayerInput(n int, newDir Direction) {
+	if g.Recorder != nil {
+		g.Recorder.record(g.GameTime, n, newDir)
+	}
+	if player := g.player(n); player != nil {
+		steer(player, newDir)
 	}
+}
 
-	if isValidMove {
-		g.PlayerSnake.NextDir = newDir
+// steer queues newDir as a turn for the snake. Each move step takes one
+// turn off the queue, so two quick presses within a step (e.g. up, then
+// left) become two consecutive turns instead of the second overwriting the
+// first. A turn that doesn't change the direction, or that would reverse the
+// snake into itself, is ignored, as are presses once the queue is full.
+func steer(s *Snake, newDir Direction) {
+	lastDir := s.Direction
+	if n := len(s.inputQueue); n > 0 {
+		lastDir = s.inputQueue[n-1]
+	}
+	if newDir == lastDir || isOpposite(newDir, lastDir) || len(s.inputQueue) >= inputQueueSize {
+		return
 	}
+	s.inputQueue = append(s.inputQueue, newDir)
+}
+
+// nextQueuedDir pops the next queued turn and returns the direction for the
+// coming move step. steer already rejects reversals against the last queued
+// turn, but the committed direction can change under the queue (a shield
+// bounce), so a turn is checked again against Direction when it is taken:
+// one that would now be a 180° turn into the neck is dropped.
+func (s *Snake) nextQueuedDir() Direction {
+	for len(s.inputQueue) > 0 {
+		dir := s.inputQueue[0]
+		s.inputQueue = s.inputQueue[1:]
+		if !isOpposite(dir, s.Direction) {
+			return dir
+		}
+	}
+	if isOpposite(s.NextDir, s.Direction) {
+		return s.Direction
+	}
+	return s.NextDir
 }
 
 // GetState provides necessary info for rendering, including progress
 type RenderableState struct {
-	PlayerSnake         *Snake
+	Players             []*Snake // Player 1 first
+	BestRun             *Snake   // Player 1 replaying the best recorded run, drawn faintly (set by the gameplay scene; nil for none)
 	EnemySnakes         []*Snake
+	EnemyPaths          [][]Position // Parallel to EnemySnakes: the A* path each enemy is following (copies)
+	EnemyIntents        []Direction  // Parallel to EnemySnakes: the way each enemy will move on its next step (its NextDir)
 	FoodItems           []*Food
-	Score               int
+	ExpiringFood        []bool    // Parallel to FoodItems: true during an item's last FoodExpiryWarning
+	NearestFood         *Position // Food nearest player 1's head (bombs aside); nil with no food or no living player 1
+	Obstacles           []Position
+	SafeZone            Bounds // Playable cells; walls fill the board outside it
+	NextSafeZone        Bounds // Where the walls are about to close in to (equals SafeZone when they aren't)
+	Scores              []int  // Parallel to Players
+	PlayerLength        int    // Segments in player 1's snake
+	Winner              int
+	ShrunkBy            int // Segments the player just lost to shrink food; 0 when there's nothing to show
+	ComboMultiplier     int
+	Difficulty          DifficultyLevel
+	Threat              float64 // How far the enemy threat ramp has got (0..1, see Game.Threat)
+	Mode                GameMode
+	TimeRemaining       float64    // Seconds left on the time attack clock
+	Walls               WallConfig // Edges of the safe zone that wrap instead of being walls
 	IsOver              bool
 	IsPaused            bool
 	GridWidth           int
 	GridHeight          int
 	PlayerSpeedFactor   float64
-	SpeedEffectDuration time.Duration
-	FoodEatenPos        *Position
-	FoodEatenTime       time.Time
-	EnemyFoodEatenPos   *Position
+	SpeedEffectDuration time.Duration // Time left on the player's speed effect; 0 when none is active
+	SpeedEffectTotal    time.Duration // Full length of that effect
+	GhostTimeLeft       float64       // Seconds the player keeps passing through snakes; 0 when not ghosting
+	GameTime            float64
+	StepCount           int
+	Flashes             []Flash // Food-eaten flashes still playing (set by the gameplay scene from EventFoodEaten)
+}
+
+// Flash is a ring flashing out from a cell where food was eaten.
+type Flash struct {
+	Pos   Position
+	Time  float64    // GameTime the food was eaten
+	Color color.RGBA // The eater's color
 }
 
 func (g *Game) GetState() RenderableState {
-	var remainingDuration time.Duration
+	var remainingDuration, totalDuration time.Duration
 
-	playerSnakeCopy := g.PlayerSnake
+	playerSnakeCopy := g.player(1)
+	if playerSnakeCopy != nil {
+		remainingDuration = time.Duration(playerSnakeCopy.SpeedEffectLeft * float64(time.Second))
+		totalDuration = time.Duration(playerSnakeCopy.SpeedEffectFull * float64(time.Second))
+	}
 	// Create a copy of the food slice to avoid modification during rendering
 	foodItemsCopy := make([]*Food, len(g.FoodItems))
 	copy(foodItemsCopy, g.FoodItems)
+	expiring := make([]bool, len(foodItemsCopy))
+	for i, food := range foodItemsCopy {
+		left := food.timeLeft(g.GameTime)
+		expiring[i] = left >= 0 && left < FoodExpiryWarning.Seconds()
+	}
+	// Copy the enemy paths too: the AI trims and replaces them as it moves
+	enemyPaths := make([][]Position, len(g.EnemySnakes))
+	enemyIntents := make([]Direction, len(g.EnemySnakes))
+	for i, enemy := range g.EnemySnakes {
+		enemyPaths[i] = slices.Clone(enemy.currentPath)
+		enemyIntents[i] = enemy.NextDir
+	}
 
 	speedFactor := 1.0
+	playerLength := 0
+	ghostLeft := 0.0
+	var nearestFood *Position
 	if playerSnakeCopy != nil {
 		speedFactor = playerSnakeCopy.SpeedFactor
+		playerLength = len(playerSnakeCopy.Body)
+		ghostLeft = max(playerSnakeCopy.GhostUntil-g.GameTime, 0)
+		if !playerSnakeCopy.Dead && len(playerSnakeCopy.Body) > 0 {
+			if food := g.nearestFood(playerSnakeCopy.Body[0]); food != nil {
+				pos := food.Pos // A copy, like the rest of the state
+				nearestFood = &pos
+			}
+		}
 	}
 
-	// Clear player food eaten effect if duration passed
-	if g.FoodEatenPos != nil && time.Since(g.FoodEatenTime) > foodFlashDuration {
-		g.FoodEatenPos = nil
+	// Let the shrink notice expire
+	if g.ShrunkBy > 0 && g.GameTime-g.ShrinkTime > shrinkNoticeTime.Seconds() {
+		g.ShrunkBy = 0
 	}
 
 	return RenderableState{
-		PlayerSnake:         playerSnakeCopy,
+		Players:             slices.Clone(g.Players),
 		EnemySnakes:         g.EnemySnakes,
+		EnemyPaths:          enemyPaths,
+		EnemyIntents:        enemyIntents,
 		FoodItems:           foodItemsCopy, // Return the slice
-		Score:               g.Score,
+		ExpiringFood:        expiring,
+		NearestFood:         nearestFood,
+		Obstacles:           g.Obstacles,
+		SafeZone:            g.SafeZone,
+		NextSafeZone:        g.NextSafeZone,
+		Scores:              slices.Clone(g.Scores),
+		PlayerLength:        playerLength,
+		Winner:              g.Winner,
+		ShrunkBy:            g.ShrunkBy,
+		ComboMultiplier:     g.ComboMultiplier(),
+		Difficulty:          g.Config.Difficulty.Level,
+		Threat:              g.Threat(),
+		Mode:                g.Config.Mode,
+		TimeRemaining:       g.TimeRemaining,
+		Walls:               g.Config.Walls,
 		IsOver:              g.IsOver,
 		IsPaused:            g.IsPaused,
-		GridWidth:           GridWidth,
-		GridHeight:          GridHeight,
+		GridWidth:           g.Width,
+		GridHeight:          g.Height,
 		PlayerSpeedFactor:   speedFactor,
 		SpeedEffectDuration: remainingDuration,
-		FoodEatenPos:        g.FoodEatenPos,
-		FoodEatenTime:       g.FoodEatenTime,
-		EnemyFoodEatenPos:   g.EnemyFoodEatenPos,
+		SpeedEffectTotal:    totalDuration,
+		GhostTimeLeft:       ghostLeft,
+		GameTime:            g.GameTime,
+		StepCount:           g.StepCount,
 	}
 }
 
 // spawnEnemyIfPossible attempts to add a new enemy if below the max count.
 func (g *Game) spawnEnemyIfPossible() {
-	if len(g.EnemySnakes) < MaxEnemySnakes {
+	if len(g.EnemySnakes) < g.Config.Difficulty.MaxEnemySnakes && !g.Practice() {
 		log.Printf("Attempting to spawn new enemy snake (current: %d)", len(g.EnemySnakes))
 		// Need to gather all currently occupied positions
 		occupied := make(map[Position]bool)
-		if g.PlayerSnake != nil {
-			for _, seg := range g.PlayerSnake.Body {
+		for _, player := range g.Players {
+			for _, seg := range player.Body {
 				occupied[seg] = true
 			}
 		}
@@ -944,10 +2136,13 @@ func (g *Game) spawnEnemyIfPossible() {
 				occupied[food.Pos] = true
 			}
 		}
+		g.markObstacles(occupied)
 
 		newEnemy := g.createEnemy(occupied)
 		if newEnemy != nil {
 			g.EnemySnakes = append(g.EnemySnakes, newEnemy)
+			g.invalidateObstacles()
+			g.emit(GameEvent{Type: EventEnemySpawned, Pos: newEnemy.Body[0], Snake: newEnemy})
 			log.Printf("New enemy snake spawned (total: %d)", len(g.EnemySnakes))
 		} else {
 			log.Printf("Failed to spawn new enemy snake (could not find placement).")
@@ -0,0 +1,216 @@
+package game
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	log.SetOutput(io.Discard) // Spawns and crashes log as they happen
+	os.Exit(m.Run())
+}
+
+// newTestGame starts a seeded round with the given rules on a board with
+// nothing but the players: no enemies, no food, no timed spawns and no
+// grace period. Tests place whatever else they need.
+func newTestGame(cfg Config) *Game {
+	cfg.Seed = 1
+	cfg.Difficulty.NumEnemySnakes = 0
+	g := NewGameWithConfig(cfg)
+	g.EnemySnakes = nil
+	g.FoodItems = nil
+	g.foodSpawnTimer = math.Inf(1)
+	g.enemySpawnTimer = math.Inf(1)
+	g.graceEndTime = 0
+	g.invalidateObstacles()
+	return g
+}
+
+// placeSnake lays s out along body, head first, moving dir.
+func placeSnake(s *Snake, dir Direction, body ...Position) {
+	s.Body = append([]Position(nil), body...)
+	s.PrevBody = append([]Position(nil), body...)
+	s.Direction, s.PrevDirection, s.NextDir = dir, dir, dir
+	s.inputQueue = nil
+	s.MoveProgress = 0
+}
+
+// stepPlayer moves player 1 exactly one cell, running one zero-length frame.
+func stepPlayer(t *testing.T, g *Game) {
+	t.Helper()
+	g.player(1).MoveProgress = 1
+	if err := g.Update(0); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+}
+
+func TestNoSelfCollision(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		noSelf bool
+	}{
+		{"passes through", true},
+		{"dies", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.NoSelfCollision = tc.noSelf
+			g := newTestGame(cfg)
+			p := g.player(1)
+			// A hook: turning down puts the head on its own body at (5,6)
+			placeSnake(p, DirRight,
+				Position{X: 5, Y: 5}, Position{X: 4, Y: 5}, Position{X: 4, Y: 6},
+				Position{X: 5, Y: 6}, Position{X: 6, Y: 6})
+			g.HandleInput(DirDown)
+			stepPlayer(t, g)
+
+			if got, want := p.Body[0], (Position{X: 5, Y: 6}); got != want {
+				t.Fatalf("head = %v, want %v", got, want)
+			}
+			if g.IsOver == tc.noSelf || p.Dead == tc.noSelf {
+				t.Errorf("IsOver = %v, Dead = %v with NoSelfCollision %v", g.IsOver, p.Dead, tc.noSelf)
+			}
+			if tc.noSelf {
+				stepPlayer(t, g) // Carries on out the other side of the body
+				if g.IsOver || p.Body[0] != (Position{X: 5, Y: 7}) {
+					t.Errorf("after passing through: head = %v, IsOver = %v", p.Body[0], g.IsOver)
+				}
+			}
+		})
+	}
+}
+
+// addEnemy puts an enemy with the given policy on the board along body,
+// head first, moving dir.
+func addEnemy(g *Game, policy TargetPolicy, dir Direction, body ...Position) *Snake {
+	s := &Snake{SpeedFactor: 1, TargetPolicy: policy, Strategy: GreedyAStarStrategy{}}
+	placeSnake(s, dir, body...)
+	g.EnemySnakes = append(g.EnemySnakes, s)
+	g.invalidateObstacles()
+	return s
+}
+
+// addFood puts a standard food item on the board at pos.
+func addFood(g *Game, pos Position) *Food {
+	food := &Food{Pos: pos, Type: FoodTypeStandard, Points: 10, Effect: g.foodEffect(FoodTypeStandard, 0)}
+	g.FoodItems = append(g.FoodItems, food)
+	return food
+}
+
+// setWalls replaces the board's interior walls with cells.
+func setWalls(g *Game, cells ...Position) {
+	g.Obstacles = cells
+	g.obstacleSet = make(map[Position]bool)
+	for _, pos := range cells {
+		g.obstacleSet[pos] = true
+	}
+	g.invalidateObstacles()
+}
+
+// boardLayout describes where the food and enemies are, for comparing rounds.
+func boardLayout(g *Game) string {
+	var b strings.Builder
+	for _, food := range g.FoodItems {
+		fmt.Fprintf(&b, "food %v %v\n", food.Pos, food.Type)
+	}
+	for _, enemy := range g.EnemySnakes {
+		fmt.Fprintf(&b, "enemy %v %v %T\n", enemy.Body, enemy.TargetPolicy, enemy.Strategy)
+	}
+	return b.String()
+}
+
+func TestNewGameWithSeedIsDeterministic(t *testing.T) {
+	a, b := NewGameWithSeed(42), NewGameWithSeed(42)
+	if len(a.EnemySnakes) == 0 || len(a.FoodItems) == 0 {
+		t.Fatalf("round starts with %d enemies and %d food items, want some of each", len(a.EnemySnakes), len(a.FoodItems))
+	}
+	if la, lb := boardLayout(a), boardLayout(b); la != lb {
+		t.Errorf("same seed, different boards:\n%s\nand\n%s", la, lb)
+	}
+	if boardLayout(a) == boardLayout(NewGameWithSeed(43)) {
+		t.Error("seeds 42 and 43 gave the same board")
+	}
+}
+
+func TestPauseFreezesRound(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	g.foodSpawnTimer, g.enemySpawnTimer = 3, 4
+	p := g.player(1)
+	p.applySpeedBoost(1.5, 5*time.Second)
+	p.MoveProgress = 0.4
+
+	g.TogglePause()
+	for range 20 {
+		if err := g.Update(0.1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g.TogglePause()
+
+	if p.SpeedFactor != 1.5 || p.SpeedEffectLeft != 5 {
+		t.Errorf("boost after the pause: factor %v, %v s left, want 1.5 and 5 s", p.SpeedFactor, p.SpeedEffectLeft)
+	}
+	if p.MoveProgress != 0.4 {
+		t.Errorf("MoveProgress after the pause = %v, want 0.4", p.MoveProgress)
+	}
+	if g.foodSpawnTimer != 3 || g.enemySpawnTimer != 4 {
+		t.Errorf("spawn timers after the pause: food %v, enemy %v, want 3 and 4", g.foodSpawnTimer, g.enemySpawnTimer)
+	}
+	if g.GameTime != 0 {
+		t.Errorf("GameTime after the pause = %v, want 0", g.GameTime)
+	}
+}
+
+func TestReachableFood(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.GridWidth, cfg.GridHeight = 8, 3
+	cfg.ReachableFood = true
+	g := newTestGame(cfg)
+	// A wall down column 6 cuts off a pocket in column 7
+	setWalls(g, Position{X: 6, Y: 0}, Position{X: 6, Y: 1}, Position{X: 6, Y: 2})
+	inPocket := func(pos Position) bool { return pos.X == 7 }
+	p := g.player(1)
+	placeSnake(p, DirRight, Position{X: 3, Y: 1}, Position{X: 2, Y: 1})
+
+	spawned := 0
+	for range 200 {
+		g.FoodItems = nil
+		g.spawnFoodItem() // Bombs keep clear of the head, so may not fit
+		for _, food := range g.FoodItems {
+			spawned++
+			if inPocket(food.Pos) {
+				t.Fatalf("food spawned at %v, in the walled-off pocket", food.Pos)
+			}
+		}
+	}
+	if spawned == 0 || g.IsOver {
+		t.Fatalf("spawned %d food items, IsOver = %v, with free cells in reach", spawned, g.IsOver)
+	}
+
+	// The snake now winds through every cell it can reach
+	var body []Position
+	for y := range 3 {
+		for x := range 6 {
+			if y%2 == 1 {
+				x = 5 - x
+			}
+			body = append(body, Position{X: x, Y: y})
+		}
+	}
+	g.FoodItems = nil
+	placeSnake(p, DirLeft, body...)
+	g.invalidateObstacles()
+	g.spawnFoodItem()
+	if len(g.FoodItems) != 0 {
+		t.Errorf("food spawned at %v with nothing left in reach", g.FoodItems[0].Pos)
+	}
+	if !g.IsOver {
+		t.Error("round goes on with no free cell in reach, want board full")
+	}
+}
@@ -0,0 +1,70 @@
+package game
+
+// Hunting: every so often an enemy, whatever its TargetPolicy, drops the
+// food and goes after the nearest player for a while. It aims a few cells
+// ahead of the player's head rather than at it, and only re-plans its path
+// every huntRetargetInterval, so a player who turns away can shake it off.
+const (
+	hunterChance         = 0.25 // Chance an enemy starts a hunt at each check
+	huntCheckInterval    = 5.0  // Game time (s) between an enemy's checks for starting a hunt
+	huntDuration         = 4.0  // Game time (s) a hunt lasts
+	huntLead             = 3    // Cells ahead of the player's head a hunter aims for
+	huntRetargetInterval = 0.5  // Game time (s) a hunter follows its path before re-aiming
+)
+
+// tickHunt counts down an enemy's hunt: it starts a hunt with hunterChance
+// once every huntCheckInterval and ends it after huntDuration. Enemies don't
+// hunt during the grace period or when no player is left.
+func (g *Game) tickHunt(s *Snake, deltaTime float64) {
+	s.huntRetarget -= deltaTime
+	s.huntTimer -= deltaTime
+	if s.huntTimer > 0 {
+		return
+	}
+	if s.Hunting {
+		s.Hunting = false
+		s.currentPath = nil // Go back to the food
+		s.huntTimer = huntCheckInterval
+		return
+	}
+	s.huntTimer = huntCheckInterval
+	if g.InGracePeriod() || len(g.livingPlayerHeads()) == 0 || g.rng.Float64() >= hunterChance {
+		return
+	}
+	s.Hunting = true
+	s.currentPath = nil
+	s.huntRetarget = 0 // Aim straight away
+	s.huntTimer = huntDuration
+}
+
+// huntTarget returns the cell a hunting enemy at pos aims for: up to
+// huntLead free cells ahead of the nearest living player's head, or the
+// free cell next to that player's head if the way ahead is blocked.
+func (g *Game) huntTarget(pos Position) (Position, bool) {
+	var prey *Snake
+	for _, player := range g.Players {
+		if player.Dead || len(player.Body) == 0 {
+			continue
+		}
+		if prey == nil || heuristic(pos, player.Body[0]) < heuristic(pos, prey.Body[0]) {
+			prey = player
+		}
+	}
+	if prey == nil {
+		return Position{}, false
+	}
+
+	obstacles := g.obstaclesFor(nil)
+	target, found := prey.Body[0], false
+	for i := 0; i < huntLead; i++ {
+		next := obstacles.step(target, prey.Direction)
+		if !isValid(next, g.Width, g.Height) || obstacles.blocked(next) {
+			break
+		}
+		target, found = next, true
+	}
+	if found {
+		return target, true
+	}
+	return g.findCellNextToPlayer(pos)
+}
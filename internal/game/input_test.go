@@ -0,0 +1,80 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSteerQueuesTurns(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		presses []Direction
+		want    []Direction
+	}{
+		{"one turn", []Direction{DirUp}, []Direction{DirUp}},
+		{"two turns", []Direction{DirUp, DirLeft}, []Direction{DirUp, DirLeft}},
+		{"reversal", []Direction{DirLeft}, nil},
+		{"current direction", []Direction{DirRight}, nil},
+		{"repeated press", []Direction{DirUp, DirUp}, []Direction{DirUp}},
+		{"reversing the queued turn", []Direction{DirUp, DirDown}, []Direction{DirUp}},
+		{"full queue", []Direction{DirUp, DirLeft, DirDown, DirRight}, []Direction{DirUp, DirLeft, DirDown}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Snake{}
+			placeSnake(s, DirRight, Position{X: 10, Y: 10}, Position{X: 9, Y: 10}, Position{X: 8, Y: 10})
+			for _, dir := range tc.presses {
+				steer(s, dir)
+			}
+			if !slices.Equal(s.inputQueue, tc.want) {
+				t.Errorf("queue = %v, want %v", s.inputQueue, tc.want)
+			}
+		})
+	}
+}
+
+func TestHandleInput(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	p := g.player(1)
+	placeSnake(p, DirRight, Position{X: 10, Y: 10}, Position{X: 9, Y: 10}, Position{X: 8, Y: 10})
+
+	g.HandleInput(DirDown)
+	g.HandlePlayerInput(2, DirUp) // Nobody is player 2
+	if want := []Direction{DirDown}; !slices.Equal(p.inputQueue, want) {
+		t.Errorf("player 1's queue = %v, want %v", p.inputQueue, want)
+	}
+
+	stepPlayer(t, g)
+	if p.Direction != DirDown || len(p.inputQueue) != 0 {
+		t.Errorf("after a step: Direction = %v, queue = %v; want down and empty", p.Direction, p.inputQueue)
+	}
+}
+
+func TestQuickTurnsWithinOneStep(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	p := g.player(1)
+	placeSnake(p, DirRight, Position{X: 10, Y: 10}, Position{X: 9, Y: 10}, Position{X: 8, Y: 10})
+
+	// Up then left before the next step: a U-turn, not a reversal into the neck
+	g.HandleInput(DirUp)
+	g.HandleInput(DirLeft)
+	for _, want := range []Position{{X: 10, Y: 9}, {X: 9, Y: 9}} {
+		stepPlayer(t, g)
+		if p.Body[0] != want || p.Dead || g.IsOver {
+			t.Fatalf("head = %v, Dead = %v, IsOver = %v; want head %v and alive", p.Body[0], p.Dead, g.IsOver, want)
+		}
+	}
+	if p.Direction != DirLeft {
+		t.Errorf("Direction = %v, want left", p.Direction)
+	}
+}
+
+func TestNextQueuedDirDropsReversal(t *testing.T) {
+	s := &Snake{}
+	placeSnake(s, DirRight, Position{X: 10, Y: 10}, Position{X: 9, Y: 10}, Position{X: 8, Y: 10})
+	steer(s, DirUp)
+	s.Direction, s.NextDir = DirDown, DirDown // A shield bounce turned the snake under the queue
+
+	if got := s.nextQueuedDir(); got != DirDown {
+		t.Errorf("nextQueuedDir = %v, want down (the queued up would reverse)", got)
+	}
+}
@@ -0,0 +1,127 @@
+package game
+
+import "math/rand"
+
+// ObstacleLayout is a preset arrangement of interior walls, generated to fit
+// the current board size. Levels loaded from a file bring their own walls and
+// ignore the layout.
+type ObstacleLayout int
+
+const (
+	LayoutNone      ObstacleLayout = iota // Open board (classic)
+	LayoutCross                           // A plus sign in the middle of the board
+	LayoutRing                            // A ring inset from the edges with a gap on each side
+	LayoutScattered                       // Random 2x2 blocks
+
+	NumObstacleLayouts = 4
+)
+
+const (
+	startLaneClearance = 8  // Cells kept free either side of a player's start
+	scatterCellsPer    = 80 // One scattered block per this many board cells
+	scatterAttempts    = 20 // Retries for a scattered layout that splits the board
+)
+
+// String returns the layout's display name.
+func (l ObstacleLayout) String() string {
+	switch l {
+	case LayoutCross:
+		return "Cross"
+	case LayoutRing:
+		return "Ring"
+	case LayoutScattered:
+		return "Scattered"
+	default:
+		return "None"
+	}
+}
+
+// generateLayout returns the wall cells for the layout on a width x height
+// board. The starting lane of every player (its start row, within a few cells
+// either side of the start) is always left free so a round never opens with a
+// wall straight ahead or under the snake's body. At least one start is required.
+func generateLayout(rng *rand.Rand, layout ObstacleLayout, width, height int, starts ...Position) []Position {
+	inLane := func(p Position) bool {
+		for _, start := range starts {
+			if p.Y == start.Y && abs(p.X-start.X) <= startLaneClearance {
+				return true
+			}
+		}
+		return false
+	}
+	var cells []Position
+	seen := make(map[Position]bool)
+	add := func(p Position) {
+		if isValid(p, width, height) && !inLane(p) && !seen[p] {
+			seen[p] = true
+			cells = append(cells, p)
+		}
+	}
+
+	switch layout {
+	case LayoutCross:
+		cx, cy := width/2, height/2
+		for x := width / 4; x < width-width/4; x++ {
+			add(Position{X: x, Y: cy})
+		}
+		for y := height / 4; y < height-height/4; y++ {
+			if y != cy {
+				add(Position{X: cx, Y: y})
+			}
+		}
+
+	case LayoutRing:
+		inset := max(min(width, height)/8, 2)
+		left, right := inset, width-1-inset
+		top, bottom := inset, height-1-inset
+		gapX, gapY := width/2, height/2
+		for x := left; x <= right; x++ {
+			if abs(x-gapX) > 1 {
+				add(Position{X: x, Y: top})
+				add(Position{X: x, Y: bottom})
+			}
+		}
+		for y := top + 1; y < bottom; y++ {
+			if abs(y-gapY) > 1 {
+				add(Position{X: left, Y: y})
+				add(Position{X: right, Y: y})
+			}
+		}
+
+	case LayoutScattered:
+		for attempt := 0; attempt < scatterAttempts; attempt++ {
+			cells = cells[:0]
+			clear(seen)
+			for i := 0; i < width*height/scatterCellsPer; i++ {
+				x, y := rng.Intn(max(width-1, 1)), rng.Intn(max(height-1, 1))
+				add(Position{X: x, Y: y})
+				add(Position{X: x + 1, Y: y})
+				add(Position{X: x, Y: y + 1})
+				add(Position{X: x + 1, Y: y + 1})
+			}
+			if layoutConnected(cells, width, height, starts[0]) {
+				return cells
+			}
+		}
+		return nil // Give up and play an open board rather than a split one
+	}
+	return cells
+}
+
+// layoutConnected reports whether every open cell is reachable from start.
+func layoutConnected(walls []Position, width, height int, start Position) bool {
+	blocked := make(map[Position]bool, len(walls))
+	for _, w := range walls {
+		blocked[w] = true
+	}
+	open := width*height - len(blocked)
+	return floodFillCount(start, width, height, obstacleView{cells: blocked}, 0) == open
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
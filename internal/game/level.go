@@ -0,0 +1,167 @@
+package game
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Level tiles used by the ASCII map format.
+const (
+	TileEmpty      = '.'
+	TileWall       = '#'
+	TileStart      = 'S'
+	TileFoodSpawn  = 'F'
+	TileEnemySpawn = 'E'
+)
+
+// Level describes a hand-designed board: its size, interior walls, where food
+// and enemies may appear, and where the player starts. The size of the map
+// becomes the size of the board.
+//
+// In the ASCII format every row is one line of text and every character one
+// cell ('#' wall, 'S' player start, 'F' food spawn, 'E' enemy spawn,
+// '.' or ' ' empty). Lines starting with ';' are comments. The player's body
+// trails to the left of 'S' (heading right) and enemy bodies trail to the
+// right of 'E' (heading left), so those cells must be free.
+type Level struct {
+	Name        string
+	Width       int
+	Height      int
+	Walls       []Position
+	FoodSpawns  []Position // Cells food prefers to appear on (empty = anywhere)
+	EnemySpawns []Position // Head positions for enemy snakes (empty = random)
+	PlayerStart Position   // Head position of the player snake
+}
+
+// levelJSON is the on-disk JSON representation: a name plus ASCII rows.
+type levelJSON struct {
+	Name string   `json:"name"`
+	Rows []string `json:"rows"`
+}
+
+// LoadLevelFile reads a level from disk. Files ending in .json are parsed as
+// {"name": ..., "rows": [...]}; anything else is treated as a plain ASCII map.
+func LoadLevelFile(path string) (*Level, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening level: %w", err)
+	}
+	defer f.Close()
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var raw levelJSON
+		if err := json.NewDecoder(f).Decode(&raw); err != nil {
+			return nil, fmt.Errorf("decoding level %s: %w", path, err)
+		}
+		if raw.Name != "" {
+			name = raw.Name
+		}
+		return parseLevelRows(name, raw.Rows)
+	}
+	return ParseLevel(name, f)
+}
+
+// ParseLevel parses an ASCII map from r.
+func ParseLevel(name string, r io.Reader) (*Level, error) {
+	var rows []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.HasPrefix(line, ";") {
+			continue // Comment
+		}
+		rows = append(rows, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading level: %w", err)
+	}
+	// Ignore trailing blank lines (common at end of file)
+	for len(rows) > 0 && strings.TrimSpace(rows[len(rows)-1]) == "" {
+		rows = rows[:len(rows)-1]
+	}
+	return parseLevelRows(name, rows)
+}
+
+// parseLevelRows builds and validates a Level from ASCII rows.
+func parseLevelRows(name string, rows []string) (*Level, error) {
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("level %q is empty", name)
+	}
+	lvl := &Level{Name: name, Width: len(rows[0]), Height: len(rows)}
+	starts := 0
+	for y, row := range rows {
+		if len(row) != lvl.Width {
+			return nil, fmt.Errorf("level %q row %d: width %d, expected %d", name, y+1, len(row), lvl.Width)
+		}
+		for x, ch := range row {
+			pos := Position{X: x, Y: y}
+			switch ch {
+			case TileEmpty, ' ':
+			case TileWall:
+				lvl.Walls = append(lvl.Walls, pos)
+			case TileStart:
+				lvl.PlayerStart = pos
+				starts++
+			case TileFoodSpawn:
+				lvl.FoodSpawns = append(lvl.FoodSpawns, pos)
+			case TileEnemySpawn:
+				lvl.EnemySpawns = append(lvl.EnemySpawns, pos)
+			default:
+				return nil, fmt.Errorf("level %q row %d col %d: unknown tile %q", name, y+1, x+1, ch)
+			}
+		}
+	}
+	if starts != 1 {
+		return nil, fmt.Errorf("level %q: needs exactly one player start 'S', found %d", name, starts)
+	}
+	if err := lvl.Validate(0); err != nil {
+		return nil, err
+	}
+	return lvl, nil
+}
+
+// Validate checks that the level is playable: start and spawn bodies have
+// room, and every open cell is reachable from the start. startLength is the
+// players' start length (Config.StartLength, zero meaning InitialSnakeLen);
+// parsing checks the level for the default, so a game started with a longer
+// snake should validate it again. Enemies always start InitialSnakeLen long.
+func (l *Level) Validate(startLength int) error {
+	walls := l.wallSet()
+
+	length := clampStartLength(startLength, l.Width)
+	for i := 0; i < length; i++ {
+		pos := Position{X: l.PlayerStart.X - i, Y: l.PlayerStart.Y}
+		if !isValid(pos, l.Width, l.Height) || walls[pos] {
+			return fmt.Errorf("level %q: player start %v has no room for a %d-long snake", l.Name, l.PlayerStart, length)
+		}
+	}
+	for _, spawn := range l.EnemySpawns {
+		for i := 0; i < InitialSnakeLen; i++ {
+			pos := Position{X: spawn.X + i, Y: spawn.Y}
+			if !isValid(pos, l.Width, l.Height) || walls[pos] {
+				return fmt.Errorf("level %q: enemy spawn %v has no room for a %d-long snake", l.Name, spawn, InitialSnakeLen)
+			}
+		}
+	}
+
+	open := l.Width*l.Height - len(walls)
+	if reachable := floodFillCount(l.PlayerStart, l.Width, l.Height, obstacleView{cells: walls}, 0); reachable != open {
+		return fmt.Errorf("level %q: %d open cells are unreachable from the start", l.Name, open-reachable)
+	}
+	return nil
+}
+
+// wallSet returns the walls as a lookup map.
+func (l *Level) wallSet() map[Position]bool {
+	walls := make(map[Position]bool, len(l.Walls))
+	for _, w := range l.Walls {
+		walls[w] = true
+	}
+	return walls
+}
@@ -0,0 +1,56 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+const testLevel = `############
+#..S.......#
+#..........#
+#.......E..#
+############`
+
+func TestLevelValidateStartLength(t *testing.T) {
+	lvl, err := ParseLevel("test", strings.NewReader(testLevel))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		length int
+		ok     bool
+	}{
+		{0, true}, // InitialSnakeLen
+		{3, true},
+		{4, false}, // Runs into the wall left of S
+		{MaxStartLength, false},
+	} {
+		if err := lvl.Validate(tc.length); (err == nil) != tc.ok {
+			t.Errorf("Validate(%d) = %v, want ok %v", tc.length, err, tc.ok)
+		}
+	}
+}
+
+func TestLongStartOnSmallBoard(t *testing.T) {
+	for _, players := range []int{1, 2} {
+		cfg := DefaultConfig()
+		cfg.GridWidth, cfg.GridHeight = 10, 6
+		cfg.StartLength = MaxStartLength
+		cfg.Players = players
+		g := newTestGame(cfg)
+
+		if len(g.Players) != players {
+			t.Fatalf("%d players placed, want %d", len(g.Players), players)
+		}
+		for i, p := range g.Players {
+			if len(p.Body) != cfg.GridWidth/2 {
+				t.Errorf("%d players: player %d is %d long, want %d (half the board)", players, i+1, len(p.Body), cfg.GridWidth/2)
+			}
+			for _, seg := range p.Body {
+				if !isValid(seg, g.Width, g.Height) {
+					t.Errorf("%d players: player %d has a segment off the board at %v", players, i+1, seg)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package game
+
+import "slices"
+
+// obstacleView is how one snake sees the board when planning: the cells of
+// a shared obstacle map, except a few that count as free from its point of
+// view (its own head, tail tips about to move away). Views never modify the
+// map, so every enemy can plan against the same one without copying it.
+// Views of the game board also know which of its edges wrap (see WallConfig).
+type obstacleView struct {
+	cells map[Position]bool
+	free  []Position
+	walls WallConfig // Edges of zone that wrap around
+	zone  Bounds     // Area the edges belong to (the safe zone)
+}
+
+// blocked reports whether p is an obstacle in this view.
+func (v obstacleView) blocked(p Position) bool {
+	if !v.cells[p] {
+		return false
+	}
+	for _, f := range v.free {
+		if f == p {
+			return false
+		}
+	}
+	return true
+}
+
+// without returns a view that also treats cells as free.
+func (v obstacleView) without(cells ...Position) obstacleView {
+	free := make([]Position, 0, len(v.free)+len(cells))
+	free = append(append(free, v.free...), cells...)
+	view := v
+	view.free = free
+	return view
+}
+
+// blocking returns a view that no longer treats cells as free, so they are
+// obstacles again wherever the map has them.
+func (v obstacleView) blocking(cells ...Position) obstacleView {
+	free := make([]Position, 0, len(v.free))
+	for _, f := range v.free {
+		if !slices.Contains(cells, f) {
+			free = append(free, f)
+		}
+	}
+	view := v
+	view.free = free
+	return view
+}
+
+// step returns the cell one move from p in dir, wrapping around the edges
+// that wrap. The result may be off the board.
+func (v obstacleView) step(p Position, dir Direction) Position {
+	return v.walls.wrap(p.step(dir), v.zone)
+}
+
+// distance is the fewest moves from a to b on an empty board, counting the
+// shortcut through wrapping edges. It never overestimates, so it serves as
+// the A* heuristic.
+func (v obstacleView) distance(a, b Position) int {
+	dist := heuristic(a, b)
+	dx, dy := abs(a.X-b.X), abs(a.Y-b.Y)
+	if v.walls.wrapsHorizontally() {
+		dist -= dx - min(dx, v.zone.Width()-dx)
+	}
+	if v.walls.wrapsVertically() {
+		dist -= dy - min(dy, v.zone.Height()-dy)
+	}
+	return dist
+}
+
+// obstaclesFor returns the board as seen by self: every snake segment, wall
+// and closed-off cell is an obstacle except self's own head. Pass nil for
+// the view of an outsider. The underlying map is built on first use and
+// shared until the board changes (see invalidateObstacles).
+func (g *Game) obstaclesFor(self *Snake) obstacleView {
+	if g.obstacleCache == nil {
+		g.obstacleCache = g.buildObstacleMap()
+	}
+	view := obstacleView{cells: g.obstacleCache, walls: g.Config.Walls, zone: g.SafeZone}
+	if self != nil && len(self.Body) > 0 {
+		view.free = []Position{self.Body[0]}
+	}
+	return view
+}
+
+// invalidateObstacles drops the shared obstacle map. Call it whenever a
+// snake moves, appears or disappears, or the walls change.
+func (g *Game) invalidateObstacles() {
+	g.obstacleCache = nil
+}
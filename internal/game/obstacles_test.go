@@ -0,0 +1,37 @@
+package game
+
+import "testing"
+
+// BenchmarkObstaclesFor measures one frame of enemy planning views on a
+// busy board: with the shared map built once per frame (cached), and
+// rebuilt for every enemy as before the cache (uncached).
+func BenchmarkObstaclesFor(b *testing.B) {
+	cfg := DefaultConfig()
+	cfg.Seed = 1
+	cfg.Difficulty = DifficultyPreset(DifficultyHard)
+	cfg.Layout = LayoutScattered
+	g := NewGameWithConfig(cfg)
+	for range cfg.Difficulty.MaxEnemySnakes {
+		g.spawnEnemyIfPossible()
+	}
+
+	for _, bc := range []struct {
+		name   string
+		cached bool
+	}{
+		{"cached", true},
+		{"uncached", false},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			for range b.N {
+				g.invalidateObstacles() // A new frame
+				for _, enemy := range g.EnemySnakes {
+					if !bc.cached {
+						g.invalidateObstacles()
+					}
+					g.obstaclesFor(enemy)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package game
+
+// Practice mode is for relaxing and growing a long snake: no enemies ever
+// appear, the player passes through their own body, walls turn the snake
+// aside like a shield would (without using one up), and nothing else is
+// fatal either. The round only ends when the player leaves it.
+
+// Practice reports whether the round is played in practice mode.
+func (g *Game) Practice() bool {
+	return g.Config.Mode == ModePractice
+}
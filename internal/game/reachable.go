@@ -0,0 +1,56 @@
+package game
+
+// markUnreachable marks every cell of the playable area no living player
+// can get to as occupied, for Config.ReachableFood, and returns the free
+// cells left. A player's own head counts as free, so a player walled in by
+// its body still reaches the cells around it.
+func (g *Game) markUnreachable(occupied map[Position]bool) []Position {
+	reach := make(map[Position]bool)
+	for _, player := range g.Players {
+		if player.Dead || len(player.Body) == 0 {
+			continue
+		}
+		for pos := range floodFill(player.Body[0], g.Width, g.Height, g.obstaclesFor(player), 0) {
+			reach[pos] = true
+		}
+	}
+
+	var free []Position
+	zone := g.SafeZone
+	for y := zone.MinY; y <= zone.MaxY; y++ {
+		for x := zone.MinX; x <= zone.MaxX; x++ {
+			pos := Position{X: x, Y: y}
+			switch {
+			case !reach[pos]:
+				occupied[pos] = true
+			case !occupied[pos]:
+				free = append(free, pos)
+			}
+		}
+	}
+	return free
+}
+
+// checkBoardFull ends the round when no food is left to eat and the snakes
+// and walls cover every cell a player can reach: there is nowhere left to
+// put food a player could get to. Empty cells walled off in a pocket don't
+// keep the round going.
+func (g *Game) checkBoardFull() {
+	if len(g.FoodItems) > 0 {
+		return
+	}
+	obstacles := g.obstaclesFor(nil)
+	occupied := make(map[Position]bool)
+	zone := g.SafeZone
+	for y := zone.MinY; y <= zone.MaxY; y++ {
+		for x := zone.MinX; x <= zone.MaxX; x++ {
+			if pos := (Position{X: x, Y: y}); obstacles.blocked(pos) {
+				occupied[pos] = true
+			}
+		}
+	}
+	if len(g.markUnreachable(occupied)) > 0 {
+		return
+	}
+	g.triggerGameOver("Board full")
+}
@@ -0,0 +1,123 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ReplayInput is one steering input pressed during a recorded round, or a
+// change of the boost key or of the time scale (Dir is DirNone).
+type ReplayInput struct {
+	Time      float64   // GameTime when it was pressed
+	Player    int       // 1 to MaxPlayers
+	Dir       Direction // Direction that was pressed
+	Boost     bool      // For a boost change: whether the key is now held
+	TimeScale float64   // For a time scale change: the new scale; 0 otherwise
+}
+
+// Replay holds everything needed to play a round again: the rules, the seed
+// the round started from and the player's inputs in order. The game is
+// deterministic given these (all randomness goes through the seeded
+// generator and the game only advances by its own GameTime), so feeding the
+// inputs back at the same game times reproduces the round exactly.
+type Replay struct {
+	Config    Config
+	Seed      int64
+	Inputs    []ReplayInput
+	Score     int     // Player 1's final score, filled in when the round ends (see Recorder.Finish)
+	TimeScale float64 // Time scale the round started at; 0 means normal speed
+}
+
+// Recorder collects the inputs of the round being played into a Replay.
+// Attach it with Game.Recorder; HandlePlayerInput, SetPlayerBoost and
+// SetTimeScale feed it.
+type Recorder struct {
+	replay Replay
+}
+
+// NewRecorder starts recording the round g is currently on. Call it right
+// after the round is reset, before any input.
+func NewRecorder(g *Game) *Recorder {
+	return &Recorder{replay: Replay{Config: g.Config, Seed: g.RoundSeed(), TimeScale: g.timeScale()}}
+}
+
+// record appends an input pressed at gameTime.
+func (r *Recorder) record(gameTime float64, player int, dir Direction) {
+	r.replay.Inputs = append(r.replay.Inputs, ReplayInput{Time: gameTime, Player: player, Dir: dir})
+}
+
+// recordBoost appends a press or release of the boost key at gameTime.
+func (r *Recorder) recordBoost(gameTime float64, player int, held bool) {
+	r.replay.Inputs = append(r.replay.Inputs, ReplayInput{Time: gameTime, Player: player, Boost: held})
+}
+
+// recordTimeScale appends a change of the time scale at gameTime.
+func (r *Recorder) recordTimeScale(gameTime, scale float64) {
+	r.replay.Inputs = append(r.replay.Inputs, ReplayInput{Time: gameTime, TimeScale: scale})
+}
+
+// Finish stamps the recording with the score g's round ended on.
+func (r *Recorder) Finish(g *Game) {
+	if len(g.Scores) > 0 {
+		r.replay.Score = g.Scores[0]
+	}
+}
+
+// Replay returns what has been recorded so far.
+func (r *Recorder) Replay() Replay {
+	return r.replay
+}
+
+// Playback feeds a recorded round back into a game in place of live input.
+type Playback struct {
+	replay Replay
+	next   int // Index of the next input to feed
+}
+
+// NewPlayback prepares a replay for playing.
+func NewPlayback(replay Replay) *Playback {
+	return &Playback{replay: replay}
+}
+
+// Start puts g back to the beginning of the recorded round, with the
+// recorded rules, seed and time scale.
+func (p *Playback) Start(g *Game) {
+	g.Config = p.replay.Config
+	g.applyBoardSize()
+	g.ResetWithSeed(p.replay.Seed)
+	g.TimeScale = p.replay.TimeScale
+	g.Replaying = true
+	p.next = 0
+}
+
+// Feed passes every recorded input that is due by g's current GameTime to
+// the game, in the order they were pressed. Call it before each g.Update,
+// exactly where live input would have been handled.
+func (p *Playback) Feed(g *Game) {
+	for p.next < len(p.replay.Inputs) && p.replay.Inputs[p.next].Time <= g.GameTime {
+		in := p.replay.Inputs[p.next]
+		switch {
+		case in.TimeScale != 0:
+			g.SetTimeScale(in.TimeScale)
+		case in.Dir == DirNone:
+			g.SetPlayerBoost(in.Player, in.Boost)
+		default:
+			g.HandlePlayerInput(in.Player, in.Dir)
+		}
+		p.next++
+	}
+}
+
+// LoadReplayFile reads a replay saved as JSON (see Replay).
+func LoadReplayFile(path string) (Replay, error) {
+	var replay Replay
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return replay, fmt.Errorf("reading replay: %w", err)
+	}
+	if err := json.Unmarshal(data, &replay); err != nil {
+		return replay, fmt.Errorf("decoding replay %s: %w", path, err)
+	}
+	return replay, nil
+}
@@ -0,0 +1,53 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResetLeavesNothingBehind(t *testing.T) {
+	g := NewGameWithSeed(3)
+	fresh := NewGameWithSeed(3)
+	for round := range 20 {
+		// Leave a round mid-flight: effects running, spawns half counted down
+		g.Simulate(200)
+		for _, s := range append(g.Players, g.EnemySnakes...) {
+			s.applySpeedBoost(1.5, 5*time.Second)
+			s.GhostUntil = g.GameTime + 5
+			steer(s, DirUp)
+		}
+		g.ComboCount, g.ComboExpiry = 3, g.GameTime+1
+		g.IsPaused = round%2 == 0
+
+		if round%2 == 0 {
+			g.Reset()
+		} else {
+			g.ResetWithSeed(int64(round))
+		}
+
+		if g.GameTime != 0 || g.IsOver || g.IsPaused || g.ComboCount != 0 || len(g.events) != 0 {
+			t.Fatalf("round %d: GameTime %v, IsOver %v, IsPaused %v, ComboCount %d, %d events after a reset",
+				round, g.GameTime, g.IsOver, g.IsPaused, g.ComboCount, len(g.events))
+		}
+		if g.foodSpawnTimer != fresh.foodSpawnTimer || g.enemySpawnTimer != fresh.enemySpawnTimer || g.graceEndTime != fresh.graceEndTime {
+			t.Fatalf("round %d: spawn timers %v/%v and grace end %v after a reset, want %v/%v and %v",
+				round, g.foodSpawnTimer, g.enemySpawnTimer, g.graceEndTime,
+				fresh.foodSpawnTimer, fresh.enemySpawnTimer, fresh.graceEndTime)
+		}
+		for _, s := range append(g.Players, g.EnemySnakes...) {
+			if s.SpeedFactor != 1 || s.SpeedEffectLeft != 0 || s.GhostUntil != 0 || len(s.inputQueue) != 0 || s.Hunting {
+				t.Fatalf("round %d: snake at %v starts with speed %v (%v s left), ghost until %v, queue %v, hunting %v",
+					round, s.Body, s.SpeedFactor, s.SpeedEffectLeft, s.GhostUntil, s.inputQueue, s.Hunting)
+			}
+		}
+	}
+
+	// A round replayed from its seed starts exactly the same
+	g.ResetWithSeed(99)
+	first := boardLayout(g)
+	g.Simulate(300)
+	g.ResetWithSeed(99)
+	if again := boardLayout(g); again != first {
+		t.Errorf("ResetWithSeed(99) twice gave different boards:\n%s\nand\n%s", first, again)
+	}
+}
@@ -0,0 +1,278 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"math/rand"
+	"time"
+)
+
+// SaveVersion is the format of the saved games written by Serialize. LoadGame
+// refuses other versions rather than resume a run it may misread.
+const SaveVersion = 1
+
+// savedGame is the JSON form of an in-progress round (see Serialize). Timers
+// are stored as game time, so a resumed run picks up exactly where it left
+// off however long it was put away.
+type savedGame struct {
+	Version         int
+	Config          Config // Mode, rules and board, including a loaded level
+	Seed            int64
+	RoundSeed       int64
+	Players         []savedSnake
+	EnemySnakes     []savedSnake
+	FoodItems       []savedFood
+	Scores          []int
+	Speed           float64
+	TimeScale       float64
+	GameTime        float64
+	TimeRemaining   float64
+	StepCount       int
+	FoodSpawnTimer  float64 // Game time (s) left until the next food item appears
+	EnemySpawnTimer float64 // Game time (s) left until the next enemy spawn check
+	GraceEndTime    float64
+	ComboCount      int
+	ComboExpiry     float64
+	ShrunkBy        int
+	ShrinkTime      float64
+	Width           int
+	Height          int
+	Obstacles       []Position
+	SafeZone        Bounds
+	NextSafeZone    Bounds
+	SafeZoneTimer   float64
+}
+
+// savedSnake is the JSON form of a Snake. Enemy paths aren't kept; they are
+// planned again on the first update.
+type savedSnake struct {
+	Body            []Position
+	PrevBody        []Position
+	Direction       Direction
+	PrevDirection   Direction
+	NextDir         Direction
+	InputQueue      []Direction
+	SpeedFactor     float64
+	SpeedEffectLeft float64
+	SpeedEffectFull float64
+	IsPlayer        bool
+	Dead            bool
+	ShieldCount     int
+	GhostUntil      float64
+	Stamina         float64
+	MoveProgress    float64
+	TargetPolicy    TargetPolicy
+	Strategy        string // See strategyNames; empty for GreedyAStarStrategy or an unknown one
+	Hunting         bool
+	HuntTimer       float64
+	HuntRetarget    float64
+	Color           color.RGBA
+	PendingGrowth   int
+	TeleportTo      *Position
+}
+
+// savedFood is the JSON form of a Food. Effect is a function, so it isn't
+// saved but rebuilt from Type and Duration (see foodEffect).
+type savedFood struct {
+	Pos       Position
+	Type      FoodType
+	Points    int
+	Duration  time.Duration
+	SpawnTime float64
+	Lifetime  time.Duration
+}
+
+// strategyNames names the built-in enemy strategies in saved games.
+var strategyNames = map[string]EnemyStrategy{
+	"greedy": GreedyAStarStrategy{},
+	"random": RandomWalkStrategy{},
+	"wall":   WallHuggerStrategy{},
+}
+
+// Serialize encodes the round in progress as JSON, for LoadGame to resume.
+// Everything needed to carry on is kept: the snakes (bodies, direction,
+// speed and other effects), the food, scores, timers and the rules. Only
+// the random number generator's state can't be, so the rest of a resumed
+// round doesn't play out as it would have without the break. A round that
+// is already over can't be saved.
+func (g *Game) Serialize() ([]byte, error) {
+	if g.IsOver {
+		return nil, errors.New("the round is over")
+	}
+	saved := savedGame{
+		Version:         SaveVersion,
+		Config:          g.Config,
+		Seed:            g.seed,
+		RoundSeed:       g.roundSeed,
+		Scores:          g.Scores,
+		Speed:           g.Speed,
+		TimeScale:       g.TimeScale,
+		GameTime:        g.GameTime,
+		TimeRemaining:   g.TimeRemaining,
+		StepCount:       g.StepCount,
+		FoodSpawnTimer:  g.foodSpawnTimer,
+		EnemySpawnTimer: g.enemySpawnTimer,
+		GraceEndTime:    g.graceEndTime,
+		ComboCount:      g.ComboCount,
+		ComboExpiry:     g.ComboExpiry,
+		ShrunkBy:        g.ShrunkBy,
+		ShrinkTime:      g.ShrinkTime,
+		Width:           g.Width,
+		Height:          g.Height,
+		Obstacles:       g.Obstacles,
+		SafeZone:        g.SafeZone,
+		NextSafeZone:    g.NextSafeZone,
+		SafeZoneTimer:   g.safeZoneTimer,
+	}
+	for _, player := range g.Players {
+		saved.Players = append(saved.Players, saveSnake(player))
+	}
+	for _, enemy := range g.EnemySnakes {
+		if enemy != nil {
+			saved.EnemySnakes = append(saved.EnemySnakes, saveSnake(enemy))
+		}
+	}
+	for _, food := range g.FoodItems {
+		if food != nil {
+			saved.FoodItems = append(saved.FoodItems, savedFood{
+				Pos:       food.Pos,
+				Type:      food.Type,
+				Points:    food.Points,
+				Duration:  food.Duration,
+				SpawnTime: food.SpawnTime,
+				Lifetime:  food.Lifetime,
+			})
+		}
+	}
+	return json.Marshal(saved)
+}
+
+// LoadGame resumes a round saved by Serialize. The game comes back paused,
+// as if left from the pause menu, and without a Recorder: a resumed round
+// can't be replayed, since its start isn't known to the recording.
+func LoadGame(data []byte) (*Game, error) {
+	var saved savedGame
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("decoding saved game: %w", err)
+	}
+	if saved.Version != SaveVersion {
+		return nil, fmt.Errorf("saved game has version %d, want %d", saved.Version, SaveVersion)
+	}
+	if saved.Width <= 0 || saved.Height <= 0 || len(saved.Players) == 0 || len(saved.Scores) != len(saved.Players) {
+		return nil, errors.New("saved game is incomplete")
+	}
+
+	g := &Game{
+		Config:          saved.Config,
+		seed:            saved.Seed,
+		roundSeed:       saved.RoundSeed,
+		rng:             rand.New(rand.NewSource(time.Now().UnixNano())),
+		Scores:          saved.Scores,
+		Speed:           saved.Speed,
+		IsPaused:        true,
+		TimeScale:       saved.TimeScale,
+		GameTime:        saved.GameTime,
+		TimeRemaining:   saved.TimeRemaining,
+		StepCount:       saved.StepCount,
+		foodSpawnTimer:  saved.FoodSpawnTimer,
+		enemySpawnTimer: saved.EnemySpawnTimer,
+		graceEndTime:    saved.GraceEndTime,
+		ComboCount:      saved.ComboCount,
+		ComboExpiry:     saved.ComboExpiry,
+		ShrunkBy:        saved.ShrunkBy,
+		ShrinkTime:      saved.ShrinkTime,
+		Width:           saved.Width,
+		Height:          saved.Height,
+		Obstacles:       saved.Obstacles,
+		obstacleSet:     make(map[Position]bool, len(saved.Obstacles)),
+		SafeZone:        saved.SafeZone,
+		NextSafeZone:    saved.NextSafeZone,
+		safeZoneTimer:   saved.SafeZoneTimer,
+	}
+	for _, pos := range g.Obstacles {
+		g.obstacleSet[pos] = true
+	}
+	for _, player := range saved.Players {
+		g.Players = append(g.Players, player.snake())
+	}
+	for _, enemy := range saved.EnemySnakes {
+		g.EnemySnakes = append(g.EnemySnakes, enemy.snake())
+	}
+	for _, food := range saved.FoodItems {
+		g.FoodItems = append(g.FoodItems, &Food{
+			Pos:       food.Pos,
+			Type:      food.Type,
+			Points:    food.Points,
+			Effect:    g.foodEffect(food.Type, food.Duration),
+			Duration:  food.Duration,
+			SpawnTime: food.SpawnTime,
+			Lifetime:  food.Lifetime,
+		})
+	}
+	return g, nil
+}
+
+// saveSnake converts s to its saved form.
+func saveSnake(s *Snake) savedSnake {
+	saved := savedSnake{
+		Body:            s.Body,
+		PrevBody:        s.PrevBody,
+		Direction:       s.Direction,
+		PrevDirection:   s.PrevDirection,
+		NextDir:         s.NextDir,
+		InputQueue:      s.inputQueue,
+		SpeedFactor:     s.SpeedFactor,
+		SpeedEffectLeft: s.SpeedEffectLeft,
+		SpeedEffectFull: s.SpeedEffectFull,
+		IsPlayer:        s.IsPlayer,
+		Dead:            s.Dead,
+		ShieldCount:     s.ShieldCount,
+		GhostUntil:      s.GhostUntil,
+		Stamina:         s.Stamina,
+		MoveProgress:    s.MoveProgress,
+		TargetPolicy:    s.TargetPolicy,
+		Hunting:         s.Hunting,
+		HuntTimer:       s.huntTimer,
+		HuntRetarget:    s.huntRetarget,
+		Color:           s.Color,
+		PendingGrowth:   s.pendingGrowth,
+		TeleportTo:      s.teleportTo,
+	}
+	for name, strategy := range strategyNames {
+		if s.Strategy == strategy {
+			saved.Strategy = name
+		}
+	}
+	return saved
+}
+
+// snake rebuilds the Snake saved in s.
+func (s savedSnake) snake() *Snake {
+	return &Snake{
+		Body:            s.Body,
+		PrevBody:        s.PrevBody,
+		Direction:       s.Direction,
+		PrevDirection:   s.PrevDirection,
+		NextDir:         s.NextDir,
+		inputQueue:      s.InputQueue,
+		SpeedFactor:     s.SpeedFactor,
+		SpeedEffectLeft: s.SpeedEffectLeft,
+		SpeedEffectFull: s.SpeedEffectFull,
+		IsPlayer:        s.IsPlayer,
+		Dead:            s.Dead,
+		ShieldCount:     s.ShieldCount,
+		GhostUntil:      s.GhostUntil,
+		Stamina:         s.Stamina,
+		MoveProgress:    s.MoveProgress,
+		TargetPolicy:    s.TargetPolicy,
+		Strategy:        strategyNames[s.Strategy],
+		Hunting:         s.Hunting,
+		huntTimer:       s.HuntTimer,
+		huntRetarget:    s.HuntRetarget,
+		Color:           s.Color,
+		pendingGrowth:   s.PendingGrowth,
+		teleportTo:      s.TeleportTo,
+	}
+}
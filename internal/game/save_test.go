@@ -0,0 +1,79 @@
+package game
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSaveRoundTrip(t *testing.T) {
+	g := NewGameWithSeed(5)
+	g.Simulate(400, SimInput{Step: 100, Dir: DirUp}, SimInput{Step: 250, Dir: DirRight})
+	if g.IsOver || len(g.EnemySnakes) == 0 {
+		t.Fatalf("want a round in progress with enemies (IsOver %v, %d enemies)", g.IsOver, len(g.EnemySnakes))
+	}
+	// Make sure the less common state is there to be saved
+	p := g.player(1)
+	p.applySpeedBoost(1.5, 4*time.Second)
+	p.grow()
+	p.ShieldCount = 2
+	steer(p, DirDown)
+	g.Scores[0] = 130
+	g.ComboCount, g.ComboExpiry = 2, g.GameTime+1
+
+	data, err := g.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadGame(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !loaded.IsPaused {
+		t.Error("loaded game isn't paused")
+	}
+	if !slices.Equal(loaded.Scores, g.Scores) || loaded.StepCount != g.StepCount || loaded.Speed != g.Speed {
+		t.Errorf("scores %v, steps %d, speed %v; want %v, %d, %v", loaded.Scores, loaded.StepCount, loaded.Speed, g.Scores, g.StepCount, g.Speed)
+	}
+	timers := func(g *Game) []float64 {
+		return []float64{g.GameTime, g.foodSpawnTimer, g.enemySpawnTimer, g.graceEndTime, g.ComboExpiry, g.TimeRemaining, g.safeZoneTimer}
+	}
+	if got, want := timers(loaded), timers(g); !slices.Equal(got, want) {
+		t.Errorf("timers = %v, want %v", got, want)
+	}
+	snakes := func(g *Game) []savedSnake {
+		var saved []savedSnake
+		for _, s := range append(g.Players, g.EnemySnakes...) {
+			saved = append(saved, saveSnake(s))
+		}
+		return saved
+	}
+	if got, want := snakes(loaded), snakes(g); !reflect.DeepEqual(got, want) {
+		t.Errorf("snakes = %+v\nwant %+v", got, want)
+	}
+	if len(loaded.FoodItems) != len(g.FoodItems) {
+		t.Fatalf("%d food items, want %d", len(loaded.FoodItems), len(g.FoodItems))
+	}
+	for i, food := range loaded.FoodItems {
+		want := *g.FoodItems[i]
+		if (food.Effect == nil) != (want.Effect == nil) {
+			t.Errorf("food %d: effect restored %v, want %v", i, food.Effect != nil, want.Effect != nil)
+		}
+		got := *food
+		got.Effect, want.Effect = nil, nil
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("food %d = %+v, want %+v", i, got, want)
+		}
+	}
+
+	// Nothing else was lost: saving again gives the same data
+	again, err := loaded.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(data) {
+		t.Errorf("saving a loaded game changed it:\n%s\nwas\n%s", again, data)
+	}
+}
@@ -0,0 +1,37 @@
+// Package game holds the rules and state of Super Snake: snakes, food,
+// enemies and their AI. It has no rendering or Ebitengine dependency (keep
+// it that way), so a Game can be run headlessly with Update or Simulate.
+package game
+
+// SimulationStep is the fixed logic step (seconds) Simulate advances by,
+// matching the gameplay scene's default tick rate.
+const SimulationStep = 1.0 / 120
+
+// SimInput steers a player before a given step of a Simulate run.
+type SimInput struct {
+	Step   int       // Zero-based step the input is handled before
+	Player int       // 1 to MaxPlayers; 0 counts as 1
+	Dir    Direction // Direction pressed
+}
+
+// Simulate runs the game headlessly for up to steps logic steps of
+// SimulationStep, handling each input just before the step it is due (inputs
+// must be in Step order; ones after the last step run are ignored). It stops
+// early when the round ends and returns the number of steps taken. With a
+// seeded game the outcome is fully reproducible, which makes it suitable for
+// AI and collision regression tests.
+func (g *Game) Simulate(steps int, inputs ...SimInput) int {
+	next := 0
+	for step := 0; step < steps; step++ {
+		if g.IsOver {
+			return step
+		}
+		for ; next < len(inputs) && inputs[next].Step <= step; next++ {
+			g.HandlePlayerInput(max(inputs[next].Player, 1), inputs[next].Dir)
+		}
+		if err := g.Update(SimulationStep); err != nil {
+			return step
+		}
+	}
+	return steps
+}
@@ -0,0 +1,49 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSimulateIntoWall(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Difficulty.InitialSpeed = 7.5 // Exactly 16 steps a cell
+	g := newTestGame(cfg)
+	placeSnake(g.player(1), DirRight, Position{X: 10, Y: 3}, Position{X: 9, Y: 3}, Position{X: 8, Y: 3})
+
+	// Up from row 3: the fourth move leaves the board
+	steps := g.Simulate(1000, SimInput{Step: 0, Dir: DirUp})
+	if !g.IsOver {
+		t.Fatal("round still running after driving into the wall")
+	}
+	if steps != 4*16 {
+		t.Errorf("Simulate returned %d steps, want %d", steps, 4*16)
+	}
+	if g.StepCount != 4 {
+		t.Errorf("StepCount = %d, want 4", g.StepCount)
+	}
+}
+
+// simulationResult summarises how a Simulate run ended.
+func simulationResult(g *Game, steps int) string {
+	return fmt.Sprintf("steps %d over %v scores %v player %v\n%s",
+		steps, g.IsOver, g.Scores, g.player(1).Body, boardLayout(g))
+}
+
+func TestSimulateIsDeterministic(t *testing.T) {
+	inputs := []SimInput{
+		{Step: 100, Dir: DirUp},
+		{Step: 250, Dir: DirRight},
+		{Step: 400, Dir: DirDown},
+		{Step: 650, Dir: DirLeft},
+		{Step: 850, Dir: DirUp},
+		{Step: 1000, Dir: DirRight},
+	}
+	run := func() string {
+		g := NewGameWithSeed(7)
+		return simulationResult(g, g.Simulate(3000, inputs...))
+	}
+	if a, b := run(), run(); a != b {
+		t.Errorf("same seed and inputs, different runs:\n%s\nand\n%s", a, b)
+	}
+}
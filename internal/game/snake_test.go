@@ -0,0 +1,86 @@
+package game
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestAdvanceKeepsPrevBodyInStep(t *testing.T) {
+	s := &Snake{}
+	placeSnake(s, DirRight, Position{X: 3, Y: 0}, Position{X: 2, Y: 0}, Position{X: 1, Y: 0})
+	s.grow()
+	s.grow()
+
+	for i := range 4 {
+		before := slices.Clone(s.Body)
+		s.advance(s.Body[0].step(DirRight))
+
+		if len(s.Body) != len(s.PrevBody) {
+			t.Fatalf("step %d: len(Body) = %d, len(PrevBody) = %d", i, len(s.Body), len(s.PrevBody))
+		}
+		wantLen := len(before)
+		if i < 2 {
+			wantLen++ // Still growing
+		}
+		if len(s.Body) != wantLen {
+			t.Fatalf("step %d: len(Body) = %d, want %d", i, len(s.Body), wantLen)
+		}
+		for j := range s.PrevBody {
+			want := before[min(j, len(before)-1)] // A new tail starts on the old tail cell
+			if s.PrevBody[j] != want {
+				t.Errorf("step %d: PrevBody[%d] = %v, want %v", i, j, s.PrevBody[j], want)
+			}
+		}
+	}
+}
+
+func TestSpeedEffectRunsOnGameTime(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	g.SetTimeScale(0.5) // Half a game second per real second
+	p := g.player(1)
+	placeSnake(p, DirRight, Position{X: 3, Y: 15}, Position{X: 2, Y: 15}, Position{X: 1, Y: 15})
+	p.applySpeedBoost(1.5, 2*time.Second)
+
+	for frame := 1; frame <= 8; frame++ {
+		if err := g.Update(0.5); err != nil {
+			t.Fatal(err)
+		}
+		if g.IsOver {
+			t.Fatal("player died")
+		}
+		expired := p.SpeedFactor == 1 && p.SpeedEffectLeft == 0
+		if frame < 8 && expired {
+			t.Fatalf("boost expired after %v s of game time, want 2 s", g.GameTime)
+		}
+		if frame == 8 && !expired {
+			t.Fatalf("boost still running after %v s of game time: factor %v, %v s left", g.GameTime, p.SpeedFactor, p.SpeedEffectLeft)
+		}
+	}
+}
+
+func TestApplySpeedBoostStacking(t *testing.T) {
+	for _, tc := range []struct {
+		name                 string
+		factor, left         float64 // Effect running beforehand (left 0 for none)
+		newFactor, newLeft   float64
+		wantFactor, wantLeft float64
+	}{
+		{"no effect running", 1, 0, 1.5, 7, 1.5, 7},
+		{"faster on faster", 1.5, 3, 1.3, 7, 1.5, 10},
+		{"stronger faster on faster", 1.3, 3, 1.5, 7, 1.5, 10},
+		{"slower on slower", 0.6, 3, 0.8, 5, 0.6, 8},
+		{"capped", 1.5, 10, 1.5, 7, 1.5, MaxSpeedEffect.Seconds()},
+		{"slower outlasts faster", 1.5, 3, 0.6, 7, 0.6, 4},
+		{"faster outlasts slower", 1.5, 7, 0.6, 3, 1.5, 4},
+		{"cancel out", 1.5, 5, 0.6, 5, 1, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Snake{SpeedFactor: tc.factor, SpeedEffectLeft: tc.left, SpeedEffectFull: tc.left}
+			s.applySpeedBoost(tc.newFactor, time.Duration(tc.newLeft*float64(time.Second)))
+			if s.SpeedFactor != tc.wantFactor || s.SpeedEffectLeft != tc.wantLeft || s.SpeedEffectFull != tc.wantLeft {
+				t.Errorf("factor %v, %v s left of %v; want %v, %v s", s.SpeedFactor, s.SpeedEffectLeft, s.SpeedEffectFull, tc.wantFactor, tc.wantLeft)
+			}
+		})
+	}
+}
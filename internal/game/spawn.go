@@ -0,0 +1,36 @@
+package game
+
+// enemySpawnLane is how far (a multiple of Config.EnemySpawnDistance) the
+// lane ahead of a player's head reaches, in which no enemy may appear.
+const enemySpawnLane = 2
+
+// nearPlayers reports whether an enemy with the given body would start
+// closer than Config.EnemySpawnDistance (Manhattan distance) to a living
+// player's head, or on the lane of cells straight ahead of it (following
+// wrapping edges), so it can't appear in the player's face.
+func (g *Game) nearPlayers(body []Position) bool {
+	limit := g.Config.EnemySpawnDistance
+	if limit <= 0 {
+		return false
+	}
+	for _, player := range g.Players {
+		if player.Dead || len(player.Body) == 0 {
+			continue
+		}
+		head := player.Body[0]
+		lane := make(map[Position]bool)
+		for pos, i := head, 0; i < enemySpawnLane*limit; i++ {
+			pos = g.wrapped(pos.step(player.Direction))
+			if !isValid(pos, g.Width, g.Height) || g.isObstacle(pos) {
+				break // The lane ends at the first wall
+			}
+			lane[pos] = true
+		}
+		for _, seg := range body {
+			if heuristic(seg, head) < limit || lane[seg] {
+				return true
+			}
+		}
+	}
+	return false
+}
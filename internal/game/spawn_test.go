@@ -0,0 +1,53 @@
+package game
+
+import "testing"
+
+func TestEnemiesSpawnAwayFromPlayers(t *testing.T) {
+	checked := 0
+	check := func(g *Game, enemies []*Snake) {
+		t.Helper()
+		limit := g.Config.EnemySpawnDistance
+		for _, enemy := range enemies {
+			checked++
+			for _, player := range g.Players {
+				head := player.Body[0]
+				// The cells straight ahead of the player, up to the board edge
+				lane := make(map[Position]bool)
+				dx, dy := player.Direction.Delta()
+				for i := 1; i <= enemySpawnLane*limit; i++ {
+					pos := Position{X: head.X + i*dx, Y: head.Y + i*dy}
+					if !isValid(pos, g.Width, g.Height) {
+						break
+					}
+					lane[pos] = true
+				}
+				for _, seg := range enemy.Body {
+					if d := heuristic(seg, head); d < limit {
+						t.Fatalf("seed %d: enemy %v spawned %d cells from the player at %v, want at least %d", g.Seed(), enemy.Body, d, head, limit)
+					}
+					if lane[seg] {
+						t.Fatalf("seed %d: enemy %v spawned in the lane ahead of the player at %v heading %v", g.Seed(), enemy.Body, head, player.Direction)
+					}
+				}
+			}
+		}
+	}
+	for seed := int64(1); seed <= 200; seed++ {
+		cfg := DefaultConfig()
+		cfg.Seed = seed
+		cfg.GridWidth, cfg.GridHeight = 20, 20 // Crowded, so spawns come close
+		cfg.Players = int(seed%2) + 1
+		cfg.Difficulty = DifficultyPreset(DifficultyHard)
+		cfg.Difficulty.MaxEnemySnakes = 12
+		g := NewGameWithConfig(cfg)
+		check(g, g.EnemySnakes)
+		for range cfg.Difficulty.MaxEnemySnakes {
+			before := len(g.EnemySnakes)
+			g.spawnEnemyIfPossible()
+			check(g, g.EnemySnakes[before:])
+		}
+	}
+	if checked < 200 {
+		t.Errorf("only %d enemies spawned to check", checked)
+	}
+}
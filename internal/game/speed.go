@@ -0,0 +1,47 @@
+package game
+
+import "slices"
+
+// SpeedCurve selects how the base speed rises with the score.
+type SpeedCurve int
+
+const (
+	SpeedLinear  SpeedCurve = iota // A little faster with every point scored
+	SpeedStepped                   // Jumps up once every Config.SpeedStepScore points
+
+	NumSpeedCurves = 2
+)
+
+// speedScoreUnit is the score counted as one step of Difficulty.SpeedIncrement
+// on the linear curve: the points of one standard food.
+const speedScoreUnit = 10
+
+// String returns the curve's display name.
+func (c SpeedCurve) String() string {
+	switch c {
+	case SpeedStepped:
+		return "Stepped"
+	default:
+		return "Linear"
+	}
+}
+
+// speedForScore returns the base speed (cells per second) for a score:
+// the difficulty's initial speed plus SpeedIncrement per standard food's
+// worth of points, following the configured curve and capped at MaxSpeed.
+func (g *Game) speedForScore(score int) float64 {
+	d := g.Config.Difficulty
+	units := float64(score) / speedScoreUnit
+	if g.Config.SpeedCurve == SpeedStepped {
+		step := max(g.Config.SpeedStepScore, 1)
+		units = float64(score/step*step) / speedScoreUnit // Whole steps only
+	}
+	return min(d.InitialSpeed+units*d.SpeedIncrement, MaxSpeed)
+}
+
+// updateSpeed sets the base speed from the score; awardPoints calls it
+// whenever a player eats. In a multiplayer round the leading score sets the
+// pace for everyone.
+func (g *Game) updateSpeed() {
+	g.Speed = g.speedForScore(slices.Max(g.Scores))
+}
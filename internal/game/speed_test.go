@@ -0,0 +1,32 @@
+package game
+
+import "testing"
+
+func TestSpeedForScore(t *testing.T) {
+	for _, tc := range []struct {
+		curve     SpeedCurve
+		stepScore int
+		score     int
+		want      float64
+	}{
+		{SpeedLinear, 50, 0, 8},
+		{SpeedLinear, 50, 10, 8.5},
+		{SpeedLinear, 50, 25, 9.25},
+		{SpeedLinear, 50, 1000, MaxSpeed},
+		{SpeedStepped, 50, 0, 8},
+		{SpeedStepped, 50, 49, 8},
+		{SpeedStepped, 50, 50, 10.5},
+		{SpeedStepped, 50, 120, 13},
+		{SpeedStepped, 50, 1000, MaxSpeed},
+		{SpeedStepped, 0, 25, 9.25}, // A step of one point is the linear curve
+	} {
+		g := newTestGame(DefaultConfig())
+		g.Config.Difficulty.InitialSpeed = 8
+		g.Config.Difficulty.SpeedIncrement = 0.5
+		g.Config.SpeedCurve = tc.curve
+		g.Config.SpeedStepScore = tc.stepScore
+		if got := g.speedForScore(tc.score); got != tc.want {
+			t.Errorf("%v curve, step %d: speedForScore(%d) = %v, want %v", tc.curve, tc.stepScore, tc.score, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package game
+
+// Boosting: a player holding the boost key moves boostFactor times faster
+// while their stamina lasts. Stamina runs from 0 to 1 and only refills once
+// the key is let go, so boosting is a burst to be timed rather than a toggle.
+const (
+	boostFactor      = 1.6 // Speed multiplier while boosting, on top of any speed food
+	staminaDrainRate = 0.5 // Stamina used per second of boosting (a full bar lasts 2s)
+	staminaRegenRate = 0.2 // Stamina regained per second without the key held
+)
+
+// SetBoost tells the game whether player 1 is holding the boost key.
+func (g *Game) SetBoost(held bool) {
+	g.SetPlayerBoost(1, held)
+}
+
+// SetPlayerBoost tells the game whether player n (1-based) is holding their
+// boost key, recording any change for a replay. It does nothing for a
+// player who isn't in the round.
+func (g *Game) SetPlayerBoost(n int, held bool) {
+	s := g.player(n)
+	if s == nil || s.boostHeld == held {
+		return
+	}
+	if g.Recorder != nil {
+		g.Recorder.recordBoost(g.GameTime, n, held)
+	}
+	s.boostHeld = held
+}
+
+// tickStamina drains stamina while the snake boosts and refills it while the
+// boost key is released. Holding the key with an empty bar does nothing.
+func (s *Snake) tickStamina(deltaTime float64) {
+	s.Boosting = s.boostHeld && s.Stamina > 0
+	switch {
+	case s.Boosting:
+		s.Stamina = max(s.Stamina-staminaDrainRate*deltaTime, 0)
+	case !s.boostHeld:
+		s.Stamina = min(s.Stamina+staminaRegenRate*deltaTime, 1)
+	}
+}
+
+// speedMultiplier returns how much faster than the base speed the snake
+// moves: its speed food effect times the boost, if it is boosting.
+func (s *Snake) speedMultiplier() float64 {
+	if s.Boosting {
+		return s.SpeedFactor * boostFactor
+	}
+	return s.SpeedFactor
+}
@@ -0,0 +1,56 @@
+package game
+
+// StartPosition picks where player 1's snake starts a round. The other
+// players start mirrored from it (see playerStarts). A level's own player
+// start overrides it.
+type StartPosition int
+
+const (
+	StartLeft   StartPosition = iota // A quarter of the way in from the left, heading right (classic)
+	StartCenter                      // In the middle of the board, heading right
+
+	NumStartPositions = 2
+)
+
+// Accepted range for Config.StartLength.
+const (
+	MinStartLength = InitialSnakeLen
+	MaxStartLength = 8
+)
+
+// String returns the start position's display name.
+func (p StartPosition) String() string {
+	if p == StartCenter {
+		return "Center"
+	}
+	return "Left"
+}
+
+// startLength returns how many segments the player snakes start with: the
+// configured length within MinStartLength and MaxStartLength, and never
+// more than half the board's width, so a snake always fits in a row.
+func (g *Game) startLength() int {
+	return clampStartLength(g.Config.StartLength, g.Width)
+}
+
+// clampStartLength applies the limits of startLength to a configured length
+// n (zero meaning InitialSnakeLen) on a board width cells wide.
+func clampStartLength(n, width int) int {
+	if n == 0 {
+		n = InitialSnakeLen
+	}
+	n = min(max(n, MinStartLength), MaxStartLength)
+	return max(min(n, width/2), 1)
+}
+
+// firstPlayerStart returns where player 1's head starts: the level's player
+// start, or the configured StartPosition.
+func (g *Game) firstPlayerStart() Position {
+	if g.Config.Level != nil {
+		return g.Config.Level.PlayerStart
+	}
+	if g.Config.StartPosition == StartCenter {
+		return Position{X: g.Width / 2, Y: g.Height / 2}
+	}
+	return Position{X: g.Width / 4, Y: g.Height / 2} // Start player on left side
+}
@@ -0,0 +1,96 @@
+package game
+
+// EnemyStrategy decides how an enemy snake moves. NextDirection is asked for
+// the snake's next move once per update; updateEnemyAI still vetoes a move
+// that leads into a dead end (see ensureEscapeRoom), so a strategy only has
+// to say where it would like to go.
+type EnemyStrategy interface {
+	NextDirection(g *Game, s *Snake) Direction
+}
+
+// enemyStrategyChance is the chance an enemy is given one of the
+// alternative strategies (RandomWalkStrategy or WallHuggerStrategy) rather
+// than GreedyAStarStrategy when it is created.
+const enemyStrategyChance = 0.25
+
+// randomWalkTurnChance is the chance a RandomWalkStrategy enemy turns at a
+// move even though the way ahead is free.
+const randomWalkTurnChance = 0.2
+
+// pickEnemyStrategy returns the strategy for a new enemy: mostly the greedy
+// A* seeker, now and then a random walker or a wall hugger.
+func (g *Game) pickEnemyStrategy() EnemyStrategy {
+	if g.rng.Float64() >= enemyStrategyChance {
+		return GreedyAStarStrategy{}
+	}
+	if g.rng.Intn(2) == 0 {
+		return RandomWalkStrategy{}
+	}
+	return WallHuggerStrategy{}
+}
+
+// GreedyAStarStrategy follows an A* path to the target picked by the
+// snake's TargetPolicy (the nearest food, for the classic enemy), wandering
+// when there is nothing to chase. Hunting enemies always move this way.
+type GreedyAStarStrategy struct{}
+
+// NextDirection implements EnemyStrategy.
+func (GreedyAStarStrategy) NextDirection(g *Game, s *Snake) Direction {
+	return g.planEnemyMove(s)
+}
+
+// RandomWalkStrategy ignores food and players: the snake keeps going
+// straight, turning at random now and then or when something is in the way.
+type RandomWalkStrategy struct{}
+
+// NextDirection implements EnemyStrategy.
+func (RandomWalkStrategy) NextDirection(g *Game, s *Snake) Direction {
+	s.currentPath = nil
+	obstacles := g.obstaclesFor(s)
+	free := g.freeDirections(s, obstacles)
+	if len(free) == 0 {
+		return g.panicDirection(s, obstacles)
+	}
+	for _, dir := range free {
+		if dir == s.Direction && g.rng.Float64() >= randomWalkTurnChance {
+			return dir
+		}
+	}
+	return free[g.rng.Intn(len(free))]
+}
+
+// WallHuggerStrategy runs along walls and other snakes: of the free moves
+// it takes the one onto the cell with the most blocked neighbours, going
+// straight on a tie. In open space it heads straight for the next wall.
+type WallHuggerStrategy struct{}
+
+// NextDirection implements EnemyStrategy.
+func (WallHuggerStrategy) NextDirection(g *Game, s *Snake) Direction {
+	s.currentPath = nil
+	obstacles := g.obstaclesFor(s)
+	free := g.freeDirections(s, obstacles)
+	if len(free) == 0 {
+		return g.panicDirection(s, obstacles)
+	}
+	best, bestWalls := free[0], -1
+	for _, dir := range free {
+		walls := g.blockedNeighbours(obstacles.step(s.Body[0], dir), obstacles)
+		if walls > bestWalls || (walls == bestWalls && dir == s.Direction) {
+			best, bestWalls = dir, walls
+		}
+	}
+	return best
+}
+
+// blockedNeighbours counts the cells next to pos that are off the board or
+// blocked.
+func (g *Game) blockedNeighbours(pos Position, obstacles obstacleView) int {
+	n := 0
+	for _, dir := range []Direction{DirUp, DirDown, DirLeft, DirRight} {
+		next := obstacles.step(pos, dir)
+		if !isValid(next, g.Width, g.Height) || obstacles.blocked(next) {
+			n++
+		}
+	}
+	return n
+}
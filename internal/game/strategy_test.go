@@ -0,0 +1,67 @@
+package game
+
+import "testing"
+
+func TestGreedyAStarStrategy(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	enemy := addEnemy(g, TargetNearestFood, DirRight,
+		Position{X: 5, Y: 5}, Position{X: 4, Y: 5}, Position{X: 3, Y: 5})
+	addFood(g, Position{X: 5, Y: 9})
+
+	if dir := (GreedyAStarStrategy{}).NextDirection(g, enemy); dir != DirDown {
+		t.Errorf("NextDirection = %v, want down towards the food", dir)
+	}
+	if n := len(enemy.currentPath); n != 4 || enemy.currentPath[n-1] != (Position{X: 5, Y: 9}) {
+		t.Errorf("path = %v, want 4 steps ending on the food", enemy.currentPath)
+	}
+}
+
+func TestRandomWalkStrategy(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	enemy := addEnemy(g, TargetNearestFood, DirRight,
+		Position{X: 5, Y: 5}, Position{X: 4, Y: 5}, Position{X: 3, Y: 5})
+	addFood(g, Position{X: 5, Y: 9}) // Ignored
+
+	straight := 0
+	const moves = 300
+	for range moves {
+		switch dir := (RandomWalkStrategy{}).NextDirection(g, enemy); dir {
+		case DirRight:
+			straight++
+		case DirLeft:
+			t.Fatal("random walker reversed into its neck")
+		}
+	}
+	if straight < moves*7/10 {
+		t.Errorf("random walker went straight %d times in %d, want mostly straight", straight, moves)
+	}
+
+	setWalls(g, Position{X: 6, Y: 5}, Position{X: 5, Y: 4}) // Only down is free
+	for range 20 {
+		if dir := (RandomWalkStrategy{}).NextDirection(g, enemy); dir != DirDown {
+			t.Fatalf("random walker with one way out went %v, want down", dir)
+		}
+	}
+}
+
+func TestWallHuggerStrategy(t *testing.T) {
+	g := newTestGame(DefaultConfig())
+	enemy := addEnemy(g, TargetNearestFood, DirRight,
+		Position{X: 5, Y: 5}, Position{X: 4, Y: 5}, Position{X: 3, Y: 5})
+
+	if dir := (WallHuggerStrategy{}).NextDirection(g, enemy); dir != DirRight {
+		t.Errorf("wall hugger in open space went %v, want straight on", dir)
+	}
+
+	setWalls(g, Position{X: 5, Y: 7}) // Two cells below
+	if dir := (WallHuggerStrategy{}).NextDirection(g, enemy); dir != DirDown {
+		t.Errorf("wall hugger went %v, want down towards the wall", dir)
+	}
+
+	// Along the top edge it keeps to the edge
+	placeSnake(enemy, DirRight, Position{X: 5, Y: 0}, Position{X: 4, Y: 0}, Position{X: 3, Y: 0})
+	setWalls(g)
+	if dir := (WallHuggerStrategy{}).NextDirection(g, enemy); dir != DirRight {
+		t.Errorf("wall hugger on the top edge went %v, want along it", dir)
+	}
+}
@@ -0,0 +1,170 @@
+package game
+
+import "time"
+
+// GameMode selects the rule set a round is played under.
+type GameMode int
+
+const (
+	ModeClassic    GameMode = iota // The whole board stays playable
+	ModeSurvival                   // Walls close in from the edges over time
+	ModePractice                   // No enemies and no death (see Practice)
+	ModeTimeAttack                 // Score as much as possible before the clock runs out (see TimeAttack)
+
+	NumGameModes = 4
+)
+
+const (
+	minSafeZoneSize = 8               // The safe zone stops closing once it is this narrow (cells)
+	safeZoneWarning = 3 * time.Second // Warning shown before the walls close in
+)
+
+// String returns the mode's display name.
+func (m GameMode) String() string {
+	switch m {
+	case ModeSurvival:
+		return "Survival"
+	case ModePractice:
+		return "Practice"
+	case ModeTimeAttack:
+		return "Time Attack"
+	default:
+		return "Classic"
+	}
+}
+
+// Bounds is an inclusive rectangle of cells.
+type Bounds struct {
+	MinX, MinY, MaxX, MaxY int
+}
+
+// boardBounds returns the bounds covering a whole width x height board.
+func boardBounds(width, height int) Bounds {
+	return Bounds{MaxX: width - 1, MaxY: height - 1}
+}
+
+// Contains reports whether p lies inside b.
+func (b Bounds) Contains(p Position) bool {
+	return p.X >= b.MinX && p.X <= b.MaxX && p.Y >= b.MinY && p.Y <= b.MaxY
+}
+
+// Width returns the number of columns in b.
+func (b Bounds) Width() int {
+	return b.MaxX - b.MinX + 1
+}
+
+// Height returns the number of rows in b.
+func (b Bounds) Height() int {
+	return b.MaxY - b.MinY + 1
+}
+
+// inset returns b moved in by n cells on each side, without letting either
+// dimension drop below minSafeZoneSize.
+func (b Bounds) inset(n int) Bounds {
+	nx := max(min(n, (b.Width()-minSafeZoneSize)/2), 0)
+	ny := max(min(n, (b.Height()-minSafeZoneSize)/2), 0)
+	return Bounds{MinX: b.MinX + nx, MinY: b.MinY + ny, MaxX: b.MaxX - nx, MaxY: b.MaxY - ny}
+}
+
+// SetMode switches the game mode and starts a fresh round in it.
+func (g *Game) SetMode(mode GameMode) {
+	g.Config.Mode = mode
+	g.Reset()
+}
+
+// resetSafeZone opens the whole board and restarts the closing timer.
+func (g *Game) resetSafeZone() {
+	g.SafeZone = boardBounds(g.Width, g.Height)
+	g.NextSafeZone = g.SafeZone
+	g.safeZoneTimer = g.safeZoneInterval()
+}
+
+// safeZoneInterval returns the game time (s) between the walls closing in.
+// A non-positive configured interval is treated as one second.
+func (g *Game) safeZoneInterval() float64 {
+	if interval := g.Config.SurvivalShrinkInterval.Seconds(); interval > 0 {
+		return interval
+	}
+	return 1
+}
+
+// updateSafeZone runs the survival clock. For the last few seconds before
+// the walls move, NextSafeZone shows where they will end up, so a snake in
+// the closing band always gets the warning period to get out of it.
+func (g *Game) updateSafeZone(deltaTime float64) {
+	if g.Config.Mode != ModeSurvival {
+		return
+	}
+	g.safeZoneTimer -= deltaTime
+	if g.safeZoneTimer <= safeZoneWarning.Seconds() {
+		g.NextSafeZone = g.SafeZone.inset(max(g.Config.SurvivalShrinkStep, 1))
+	}
+	if g.safeZoneTimer <= 0 {
+		g.closeSafeZone()
+		g.safeZoneTimer += g.safeZoneInterval()
+	}
+}
+
+// closeSafeZone moves the walls in to NextSafeZone. Snakes whose head is
+// caught outside die; a tail left outside is cut off at the wall. Food
+// outside the zone is lost.
+func (g *Game) closeSafeZone() {
+	if g.NextSafeZone == g.SafeZone {
+		return // Already as small as it gets
+	}
+	g.SafeZone = g.NextSafeZone
+	g.invalidateObstacles()
+
+	for _, player := range g.Players {
+		if !player.Dead && !g.trimToSafeZone(player) {
+			g.killPlayer(player, "Caught outside the safe zone")
+		}
+	}
+	for _, enemy := range append([]*Snake(nil), g.EnemySnakes...) {
+		if !g.trimToSafeZone(enemy) {
+			g.removeEnemySnake(enemy)
+		}
+	}
+
+	kept := g.FoodItems[:0]
+	for _, food := range g.FoodItems {
+		if g.SafeZone.Contains(food.Pos) {
+			kept = append(kept, food)
+		}
+	}
+	clear(g.FoodItems[len(kept):])
+	g.FoodItems = kept
+}
+
+// trimToSafeZone cuts s off at the first segment outside the safe zone and
+// reports whether the snake survived (its head is inside).
+func (g *Game) trimToSafeZone(s *Snake) bool {
+	for i, seg := range s.Body {
+		if g.SafeZone.Contains(seg) {
+			continue
+		}
+		if i == 0 {
+			return false
+		}
+		s.Body = s.Body[:i]
+		s.PrevBody = s.PrevBody[:i]
+		s.pendingGrowth = 0
+		s.currentPath = nil
+		break
+	}
+	return true
+}
+
+// markClosedCells adds every cell outside the safe zone to the occupancy map.
+func (g *Game) markClosedCells(occupied map[Position]bool) {
+	if g.SafeZone == boardBounds(g.Width, g.Height) {
+		return
+	}
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < g.Width; x++ {
+			if p := (Position{X: x, Y: y}); !g.SafeZone.Contains(p) {
+				occupied[p] = true
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package game
+
+import (
+	"log"
+	"slices"
+)
+
+// Time attack: the round runs against a clock (Config.TimeAttackLimit)
+// instead of until death. Crashing costs timeAttackDeathPenalty and puts
+// the snake back at its start at the starting length; the round ends when
+// the clock runs out.
+
+// timeAttackDeathPenalty is the time (s) a crash takes off the clock.
+const timeAttackDeathPenalty = 10.0
+
+// TimeAttack reports whether the round is played in time attack mode.
+func (g *Game) TimeAttack() bool {
+	return g.Config.Mode == ModeTimeAttack
+}
+
+// timeAttackLimit returns the length (s) of a time attack round. A
+// non-positive configured limit is treated as one second.
+func (g *Game) timeAttackLimit() float64 {
+	if limit := g.Config.TimeAttackLimit.Seconds(); limit > 0 {
+		return limit
+	}
+	return 1
+}
+
+// resetTimeAttack winds the clock back to the full limit in time attack, or
+// clears it in the other modes.
+func (g *Game) resetTimeAttack() {
+	g.TimeRemaining = 0
+	if g.TimeAttack() {
+		g.TimeRemaining = g.timeAttackLimit()
+	}
+}
+
+// updateTimeAttack runs the clock down and ends the round when it's out.
+func (g *Game) updateTimeAttack(deltaTime float64) {
+	if !g.TimeAttack() {
+		return
+	}
+	g.TimeRemaining -= deltaTime
+	if g.TimeRemaining <= 0 {
+		g.timeUp()
+	}
+}
+
+// timeUp ends a time attack round. In a multiplayer round the highest
+// score wins, and a shared top score is a draw.
+func (g *Game) timeUp() {
+	g.TimeRemaining = 0
+	if len(g.Players) > 1 {
+		g.Winner = 0
+		best := -1
+		for i, score := range g.Scores {
+			switch {
+			case score > best:
+				best, g.Winner = score, i+1
+			case score == best:
+				g.Winner = 0
+			}
+		}
+	}
+	g.triggerGameOver("Time's up")
+}
+
+// crashInTimeAttack handles a fatal hit on player s in time attack: the
+// crash costs time and s starts over, unless that used up the clock.
+func (g *Game) crashInTimeAttack(s *Snake) {
+	g.emit(GameEvent{Type: EventPlayerDied, Pos: headOf(s), Snake: s, Body: slices.Clone(s.Body)})
+	s.Dead = true // Until respawnPlayer brings it back
+	g.TimeRemaining -= timeAttackDeathPenalty
+	if g.TimeRemaining <= 0 {
+		g.timeUp()
+		return
+	}
+	g.respawnPlayer(s)
+}
+
+// respawnPlayer puts player s back at the player's start (or the nearest
+// free row) as a fresh snake, keeping the state of their boost key. The
+// snake is reset in place rather than replaced: Update may still be looping
+// over g.Players, and queued events point at s.
+func (g *Game) respawnPlayer(s *Snake) {
+	n := slices.Index(g.Players, s)
+	if n < 0 {
+		return
+	}
+	occupied := make(map[Position]bool)
+	for pos := range g.obstaclesFor(nil).cells {
+		occupied[pos] = true
+	}
+	fresh := g.createPlayer(g.playerStarts(g.firstPlayerStart())[n], occupied)
+	if fresh == nil {
+		log.Printf("Warning: Could not respawn player %d", n+1)
+		g.timeUp()
+		return
+	}
+	fresh.boostHeld = s.boostHeld
+	*s = *fresh
+	g.invalidateObstacles()
+}
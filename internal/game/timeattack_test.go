@@ -0,0 +1,48 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTimeAttackRespawnKeepsSnake(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Mode = ModeTimeAttack
+	cfg.Players = 2
+	g := newTestGame(cfg)
+	p1, p2 := g.player(1), g.player(2)
+	start := slices.Clone(p1.Body)
+	// Player 1 (moving first) crashes into the top wall on the frame
+	placeSnake(p1, DirUp, Position{X: 10, Y: 0}, Position{X: 10, Y: 1}, Position{X: 10, Y: 2})
+	crashed := slices.Clone(p1.Body)
+	crashed[0], crashed[1], crashed[2] = Position{X: 10, Y: -1}, crashed[0], crashed[1]
+	p1.MoveProgress, p2.MoveProgress = 1, 1
+	p2Head := p2.Body[0]
+	timeLeft := g.TimeRemaining
+
+	if err := g.Update(0); err != nil {
+		t.Fatal(err)
+	}
+	if g.IsOver || g.player(1) != p1 || g.player(2) != p2 {
+		t.Fatalf("IsOver = %v, players replaced: %v", g.IsOver, g.player(1) != p1 || g.player(2) != p2)
+	}
+	if p1.Dead || !slices.Equal(p1.Body, start) || p1.MoveProgress != 0 {
+		t.Errorf("player 1 after the crash: Dead = %v, Body = %v, MoveProgress = %v; want alive at %v", p1.Dead, p1.Body, p1.MoveProgress, start)
+	}
+	if p2.Body[0] == p2Head {
+		t.Error("player 2 didn't move on the frame player 1 crashed")
+	}
+	if got, want := g.TimeRemaining, timeLeft-timeAttackDeathPenalty; got != want {
+		t.Errorf("TimeRemaining = %v, want %v", got, want)
+	}
+
+	var deaths []GameEvent
+	for _, ev := range g.DrainEvents() {
+		if ev.Type == EventPlayerDied {
+			deaths = append(deaths, ev)
+		}
+	}
+	if len(deaths) != 1 || deaths[0].Snake != p1 || !slices.Equal(deaths[0].Body, crashed) {
+		t.Errorf("death events = %+v, want one for player 1 with the crashed body %v", deaths, crashed)
+	}
+}
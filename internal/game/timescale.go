@@ -0,0 +1,45 @@
+package game
+
+import "math"
+
+// Time scale: Game.TimeScale stretches or squeezes the time every Update
+// advances, so the whole simulation (movement, spawning, effect timers) runs
+// in slow motion below 1 and fast-forward above it.
+const (
+	MinTimeScale = 0.1 // Slowest the game can be slowed to
+	MaxTimeScale = 4.0 // Fastest it can be sped up to
+)
+
+// SetTimeScale sets how fast game time runs relative to real time, clamped
+// to MinTimeScale..MaxTimeScale. Zero, negative and NaN scales restore
+// normal speed rather than freezing or reversing the game. The change is
+// recorded for a replay.
+func (g *Game) SetTimeScale(scale float64) {
+	if math.IsNaN(scale) || scale <= 0 {
+		scale = 1
+	}
+	scale = min(max(scale, MinTimeScale), MaxTimeScale)
+	if scale == g.timeScale() {
+		return
+	}
+	if g.Recorder != nil {
+		g.Recorder.recordTimeScale(g.GameTime, scale)
+	}
+	g.TimeScale = scale
+}
+
+// ScaledTime converts real seconds into game seconds at the current time
+// scale, e.g. so presentation effects keep pace with the game.
+func (g *Game) ScaledTime(seconds float64) float64 {
+	return seconds * g.timeScale()
+}
+
+// timeScale returns the time scale in effect: TimeScale clamped to the
+// allowed range, with the zero value (or anything invalid set directly)
+// counting as normal speed.
+func (g *Game) timeScale() float64 {
+	if math.IsNaN(g.TimeScale) || g.TimeScale <= 0 {
+		return 1
+	}
+	return min(max(g.TimeScale, MinTimeScale), MaxTimeScale)
+}
@@ -0,0 +1,132 @@
+package input
+
+import (
+	"errors"
+	"io/fs"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"snake-game/internal/storage"
+)
+
+// bindingsFile is the key binding config in the user config directory.
+const bindingsFile = "bindings.json"
+
+// Bindings maps each movement direction and action to one or more keys.
+// Keys are stored in the config file by name, e.g. "ArrowUp" or "W".
+type Bindings struct {
+	Up      []ebiten.Key `json:"up"`
+	Down    []ebiten.Key `json:"down"`
+	Left    []ebiten.Key `json:"left"`
+	Right   []ebiten.Key `json:"right"`
+	Pause   []ebiten.Key `json:"pause"`
+	Confirm []ebiten.Key `json:"confirm"`
+	Back    []ebiten.Key `json:"back"`
+	Restart []ebiten.Key `json:"restart"`
+	Boost   []ebiten.Key `json:"boost"` // Held rather than pressed
+
+	// Players holds the keys of players 2 to game.MaxPlayers, in order, for
+	// a multiplayer round. Player 1 stops using any key bound to a player
+	// in the round.
+	Players []PlayerKeys `json:"players"`
+}
+
+// PlayerKeys are one player's own movement and boost keys.
+type PlayerKeys struct {
+	Up    []ebiten.Key `json:"up"`
+	Down  []ebiten.Key `json:"down"`
+	Left  []ebiten.Key `json:"left"`
+	Right []ebiten.Key `json:"right"`
+	Boost []ebiten.Key `json:"boost"`
+}
+
+// all returns every key bound for the player.
+func (k PlayerKeys) all() []ebiten.Key {
+	return concatKeys(k.Up, k.Down, k.Left, k.Right, k.Boost)
+}
+
+// DefaultBindings returns the standard layout: arrows/WASD to move,
+// P/Esc to pause, Enter/Space to confirm, Backspace/Q to go back, R to restart,
+// Shift held to boost. In a multiplayer round WASD and left Shift belong to
+// player 2, IJKL and U to player 3, the numpad (8/5/4/6, 0 to boost) to
+// player 4, and the arrows and right Shift to player 1.
+func DefaultBindings() Bindings {
+	return Bindings{
+		Up:      []ebiten.Key{ebiten.KeyArrowUp, ebiten.KeyW},
+		Down:    []ebiten.Key{ebiten.KeyArrowDown, ebiten.KeyS},
+		Left:    []ebiten.Key{ebiten.KeyArrowLeft, ebiten.KeyA},
+		Right:   []ebiten.Key{ebiten.KeyArrowRight, ebiten.KeyD},
+		Pause:   []ebiten.Key{ebiten.KeyP, ebiten.KeyEscape},
+		Confirm: []ebiten.Key{ebiten.KeyEnter, ebiten.KeySpace},
+		Back:    []ebiten.Key{ebiten.KeyBackspace, ebiten.KeyQ},
+		Restart: []ebiten.Key{ebiten.KeyR},
+		Boost:   []ebiten.Key{ebiten.KeyShiftLeft, ebiten.KeyShiftRight},
+		Players: []PlayerKeys{
+			{
+				Up:    []ebiten.Key{ebiten.KeyW},
+				Down:  []ebiten.Key{ebiten.KeyS},
+				Left:  []ebiten.Key{ebiten.KeyA},
+				Right: []ebiten.Key{ebiten.KeyD},
+				Boost: []ebiten.Key{ebiten.KeyShiftLeft},
+			},
+			{
+				Up:    []ebiten.Key{ebiten.KeyI},
+				Down:  []ebiten.Key{ebiten.KeyK},
+				Left:  []ebiten.Key{ebiten.KeyJ},
+				Right: []ebiten.Key{ebiten.KeyL},
+				Boost: []ebiten.Key{ebiten.KeyU},
+			},
+			{
+				Up:    []ebiten.Key{ebiten.KeyNumpad8},
+				Down:  []ebiten.Key{ebiten.KeyNumpad5},
+				Left:  []ebiten.Key{ebiten.KeyNumpad4},
+				Right: []ebiten.Key{ebiten.KeyNumpad6},
+				Boost: []ebiten.Key{ebiten.KeyNumpad0},
+			},
+		},
+	}
+}
+
+// LoadBindings reads the key binding config, falling back to the defaults
+// when no file exists. Entries missing or empty in the file keep their
+// default keys so a partial config can never leave an action unbound.
+func LoadBindings() (Bindings, error) {
+	var loaded Bindings
+	if err := storage.LoadJSON(bindingsFile, &loaded); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return DefaultBindings(), nil
+		}
+		return DefaultBindings(), err
+	}
+	return loaded.withDefaults(), nil
+}
+
+// withDefaults fills any empty entry from DefaultBindings.
+func (b Bindings) withDefaults() Bindings {
+	def := DefaultBindings()
+	fill := func(keys *[]ebiten.Key, fallback []ebiten.Key) {
+		if len(*keys) == 0 {
+			*keys = fallback
+		}
+	}
+	fill(&b.Up, def.Up)
+	fill(&b.Down, def.Down)
+	fill(&b.Left, def.Left)
+	fill(&b.Right, def.Right)
+	fill(&b.Pause, def.Pause)
+	fill(&b.Confirm, def.Confirm)
+	fill(&b.Back, def.Back)
+	fill(&b.Restart, def.Restart)
+	fill(&b.Boost, def.Boost)
+	players := make([]PlayerKeys, len(def.Players))
+	copy(players, b.Players)
+	for i := range players {
+		fill(&players[i].Up, def.Players[i].Up)
+		fill(&players[i].Down, def.Players[i].Down)
+		fill(&players[i].Left, def.Players[i].Left)
+		fill(&players[i].Right, def.Players[i].Right)
+		fill(&players[i].Boost, def.Players[i].Boost)
+	}
+	b.Players = players
+	return b
+}
@@ -0,0 +1,138 @@
+package input
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const (
+	stickPressThreshold   = 0.5 // Axis value past which the stick counts as pushed
+	stickReleaseThreshold = 0.3 // Axis value below which the stick counts as centred again
+)
+
+// gamepadState tracks the active pad and analog stick debouncing.
+type gamepadState struct {
+	id        ebiten.GamepadID
+	connected bool
+	stickDir  Direction // Direction the stick is currently held in (DirNone when centred)
+}
+
+// updateGamepad reads the first connected standard-layout gamepad.
+// The D-pad and left stick move; A confirms, Start pauses, B goes back.
+// A stick flick yields a single direction until it returns to centre.
+func (m *Manager) updateGamepad() (Direction, Action) {
+	pad := &m.gamepad
+	if !pad.refresh() {
+		return DirNone, ActionNone
+	}
+	id := pad.id
+
+	// D-pad
+	switch {
+	case inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonLeftTop):
+		return DirUp, ActionNone
+	case inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonLeftBottom):
+		return DirDown, ActionNone
+	case inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonLeftLeft):
+		return DirLeft, ActionNone
+	case inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonLeftRight):
+		return DirRight, ActionNone
+	}
+
+	// Left stick
+	if dir := pad.readStick(); dir != DirNone {
+		return dir, ActionNone
+	}
+
+	// Face buttons
+	switch {
+	case inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonCenterRight):
+		return DirNone, ActionPause
+	case inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonRightBottom):
+		return DirNone, ActionConfirm
+	case inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonRightRight):
+		return DirNone, ActionBack
+	}
+
+	return DirNone, ActionNone
+}
+
+// gamepadBoostHeld reports whether the right shoulder button is held.
+func (m *Manager) gamepadBoostHeld() bool {
+	return m.gamepad.connected && ebiten.IsStandardGamepadButtonPressed(m.gamepad.id, ebiten.StandardGamepadButtonFrontTopRight)
+}
+
+// gamepadHeldDirection returns the direction held on the D-pad, or else the
+// one the left stick is pushed in, or DirNone.
+func (m *Manager) gamepadHeldDirection() Direction {
+	pad := &m.gamepad
+	if !pad.refresh() {
+		return DirNone
+	}
+	switch {
+	case ebiten.IsStandardGamepadButtonPressed(pad.id, ebiten.StandardGamepadButtonLeftTop):
+		return DirUp
+	case ebiten.IsStandardGamepadButtonPressed(pad.id, ebiten.StandardGamepadButtonLeftBottom):
+		return DirDown
+	case ebiten.IsStandardGamepadButtonPressed(pad.id, ebiten.StandardGamepadButtonLeftLeft):
+		return DirLeft
+	case ebiten.IsStandardGamepadButtonPressed(pad.id, ebiten.StandardGamepadButtonLeftRight):
+		return DirRight
+	}
+	return pad.stickDir // Kept up to date by readStick
+}
+
+// refresh picks the pad to read from, dropping a pad that was unplugged.
+// Returns false if no usable gamepad is connected.
+func (p *gamepadState) refresh() bool {
+	ids := ebiten.AppendGamepadIDs(nil)
+	if p.connected {
+		for _, id := range ids {
+			if id == p.id {
+				return true
+			}
+		}
+		// Pad disconnected mid-game: forget it and its stick state
+		p.connected = false
+		p.stickDir = DirNone
+	}
+	for _, id := range ids {
+		if ebiten.IsStandardGamepadLayoutAvailable(id) {
+			p.id = id
+			p.connected = true
+			return true
+		}
+	}
+	return false
+}
+
+// readStick returns a direction only on the frame the stick is first pushed
+// into it; holding or drifting within the same direction returns DirNone.
+func (p *gamepadState) readStick() Direction {
+	x := ebiten.StandardGamepadAxisValue(p.id, ebiten.StandardGamepadAxisLeftStickHorizontal)
+	y := ebiten.StandardGamepadAxisValue(p.id, ebiten.StandardGamepadAxisLeftStickVertical)
+
+	if math.Abs(x) < stickReleaseThreshold && math.Abs(y) < stickReleaseThreshold {
+		p.stickDir = DirNone // Back to centre, ready for the next flick
+		return DirNone
+	}
+
+	dir := DirNone
+	switch {
+	case math.Abs(x) >= math.Abs(y) && x >= stickPressThreshold:
+		dir = DirRight
+	case math.Abs(x) >= math.Abs(y) && x <= -stickPressThreshold:
+		dir = DirLeft
+	case math.Abs(y) > math.Abs(x) && y >= stickPressThreshold:
+		dir = DirDown
+	case math.Abs(y) > math.Abs(x) && y <= -stickPressThreshold:
+		dir = DirUp
+	}
+	if dir == DirNone || dir == p.stickDir {
+		return DirNone
+	}
+	p.stickDir = dir
+	return dir
+}
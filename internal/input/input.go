@@ -1,10 +1,26 @@
+// Package input turns keyboard and gamepad state into directions and
+// actions. It doesn't depend on the game package: directions are its own
+// Direction type, which the gameplay scene translates into the game's, so
+// bindings and devices can grow here without tying input to the rules.
 package input
 
 import (
+	"log"
+	"slices"
+
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Direction is a direction pressed or held.
+type Direction int
 
-	"snake-game/internal/game" // For game.Direction
+const (
+	DirNone Direction = iota
+	DirUp
+	DirDown
+	DirLeft
+	DirRight
 )
 
 // Action represents a game action triggered by input.
@@ -24,43 +40,206 @@ const (
 
 // Manager handles reading input state.
 type Manager struct {
-	// We could add configuration here later, e.g., key bindings
+	bindings Bindings
+	gamepad  gamepadState
 }
 
-// NewManager creates a new input manager.
+// NewManager creates a new input manager using the saved key bindings
+// (or the defaults if none are saved).
 func NewManager() *Manager {
-	return &Manager{}
+	bindings, err := LoadBindings()
+	if err != nil {
+		log.Printf("Warning: Failed to load key bindings, using defaults: %v", err)
+	}
+	return &Manager{bindings: bindings}
+}
+
+// Bindings returns the active key bindings.
+func (m *Manager) Bindings() Bindings {
+	return m.bindings
+}
+
+// SetBindings replaces the active key bindings.
+func (m *Manager) SetBindings(b Bindings) {
+	m.bindings = b.withDefaults()
 }
 
 // Update checks the current input state and returns relevant actions/directions.
 // This simple version directly returns the first detected movement direction.
 // A more complex game might queue actions.
-func (m *Manager) Update() (game.Direction, Action) {
-	// Check for movement keys (prioritize arrows, then WASD)
-	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) || inpututil.IsKeyJustPressed(ebiten.KeyW) {
-		return game.DirUp, ActionNone
+func (m *Manager) Update() (Direction, Action) {
+	b := m.bindings
+
+	// Check for movement keys first
+	if anyJustPressed(b.Up) {
+		return DirUp, ActionNone
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) || inpututil.IsKeyJustPressed(ebiten.KeyS) {
-		return game.DirDown, ActionNone
+	if anyJustPressed(b.Down) {
+		return DirDown, ActionNone
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) || inpututil.IsKeyJustPressed(ebiten.KeyA) {
-		return game.DirLeft, ActionNone
+	if anyJustPressed(b.Left) {
+		return DirLeft, ActionNone
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) || inpututil.IsKeyJustPressed(ebiten.KeyD) {
-		return game.DirRight, ActionNone
+	if anyJustPressed(b.Right) {
+		return DirRight, ActionNone
 	}
 
 	// Check for action keys
-	if inpututil.IsKeyJustPressed(ebiten.KeyP) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
-		// Use Escape primarily for pausing during gameplay, maybe backing out of menus
-		return game.DirNone, ActionPause // For now, map both to Pause
+	if action := justPressedAction(b); action != ActionNone {
+		return DirNone, action
+	}
+
+	// Fall back to the gamepad (keyboard and pad work side by side)
+	return m.updateGamepad()
+}
+
+// UpdatePlayers reads input for a multiplayer round of n players and
+// returns each player's direction (DirNone if they didn't turn) and the
+// action pressed. Player 1 steers with the movement bindings (minus any keys
+// bound to the other players in the round) and the gamepad, the others with
+// their own keys; all can turn on the same frame.
+func (m *Manager) UpdatePlayers(n int) ([]Direction, Action) {
+	b := m.bindings
+	others, taken := m.otherPlayers(n)
+
+	dirs := make([]Direction, 1, n)
+	dirs[0] = justPressedDirection(without(b.Up, taken), without(b.Down, taken), without(b.Left, taken), without(b.Right, taken))
+	for _, keys := range others {
+		dirs = append(dirs, justPressedDirection(keys.Up, keys.Down, keys.Left, keys.Right))
+	}
+	action := justPressedAction(b)
+
+	padDir, padAction := m.updateGamepad()
+	if dirs[0] == DirNone {
+		dirs[0] = padDir
+	}
+	if action == ActionNone {
+		action = padAction
+	}
+	return dirs, action
+}
+
+// BoostHeld reports whether the boost key (or the gamepad's right shoulder
+// button) is held down in a one-player round.
+func (m *Manager) BoostHeld() bool {
+	return anyPressed(m.bindings.Boost) || m.gamepadBoostHeld()
+}
+
+// CurrentHeldDirection returns the direction held down in a one-player
+// round, or DirNone. Unlike Update, which reports a direction only on the
+// frame its key is pressed, it keeps reporting it for as long as the key is
+// held; of several held keys the one pressed last wins. Without a direction
+// key held it falls back to the gamepad's D-pad and left stick.
+func (m *Manager) CurrentHeldDirection() Direction {
+	b := m.bindings
+	held, newest := DirNone, 0
+	for _, bound := range []struct {
+		keys []ebiten.Key
+		dir  Direction
+	}{{b.Up, DirUp}, {b.Down, DirDown}, {b.Left, DirLeft}, {b.Right, DirRight}} {
+		for _, k := range bound.keys {
+			if frames := inpututil.KeyPressDuration(k); frames > 0 && (held == DirNone || frames < newest) {
+				held, newest = bound.dir, frames
+			}
+		}
 	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
-		// Use Space primarily for restarting when game over, Enter for menu confirm
-		return game.DirNone, ActionConfirm // For now, map both to Confirm
+	if held != DirNone {
+		return held
 	}
-	// Add ActionRestart check if needed (e.g., R key)
-	// Add ActionBack check if needed (e.g., Backspace or specific key for menus)
+	return m.gamepadHeldDirection()
+}
+
+// BoostHeldPlayers reports whether each of n players is holding their boost
+// key in a multiplayer round. As with steering, player 1 stops using keys
+// bound to the other players, and the gamepad belongs to player 1.
+func (m *Manager) BoostHeldPlayers(n int) []bool {
+	others, taken := m.otherPlayers(n)
+	held := make([]bool, 1, n)
+	held[0] = anyPressed(without(m.bindings.Boost, taken)) || m.gamepadBoostHeld()
+	for _, keys := range others {
+		held = append(held, anyPressed(keys.Boost))
+	}
+	return held
+}
+
+// otherPlayers returns the keys of players 2 to n, and all of those keys in
+// one list (which player 1 gives up).
+func (m *Manager) otherPlayers(n int) (others []PlayerKeys, taken []ebiten.Key) {
+	others = m.bindings.Players[:min(max(n-1, 0), len(m.bindings.Players))]
+	for _, keys := range others {
+		taken = append(taken, keys.all()...)
+	}
+	return others, taken
+}
+
+// justPressedDirection returns the direction whose keys were pressed this
+// frame, checked in up, down, left, right order.
+func justPressedDirection(up, down, left, right []ebiten.Key) Direction {
+	switch {
+	case anyJustPressed(up):
+		return DirUp
+	case anyJustPressed(down):
+		return DirDown
+	case anyJustPressed(left):
+		return DirLeft
+	case anyJustPressed(right):
+		return DirRight
+	}
+	return DirNone
+}
 
-	return game.DirNone, ActionNone // No relevant input detected
+// justPressedAction returns the action whose keys were pressed this frame.
+func justPressedAction(b Bindings) Action {
+	switch {
+	case anyJustPressed(b.Pause):
+		return ActionPause
+	case anyJustPressed(b.Confirm):
+		return ActionConfirm
+	case anyJustPressed(b.Restart):
+		return ActionRestart
+	case anyJustPressed(b.Back):
+		// Kept separate from Pause so menus can tell "back out" from "toggle pause"
+		return ActionBack
+	}
+	return ActionNone
+}
+
+// concatKeys joins several key lists into one.
+func concatKeys(lists ...[]ebiten.Key) []ebiten.Key {
+	var all []ebiten.Key
+	for _, keys := range lists {
+		all = append(all, keys...)
+	}
+	return all
+}
+
+// without returns keys minus any that appear in exclude.
+func without(keys, exclude []ebiten.Key) []ebiten.Key {
+	kept := make([]ebiten.Key, 0, len(keys))
+	for _, k := range keys {
+		if !slices.Contains(exclude, k) {
+			kept = append(kept, k)
+		}
+	}
+	return kept
+}
+
+// anyPressed reports whether any of the keys is held down.
+func anyPressed(keys []ebiten.Key) bool {
+	for _, k := range keys {
+		if ebiten.IsKeyPressed(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// anyJustPressed reports whether any of the keys was pressed this frame.
+func anyJustPressed(keys []ebiten.Key) bool {
+	for _, k := range keys {
+		if inpututil.IsKeyJustPressed(k) {
+			return true
+		}
+	}
+	return false
 }
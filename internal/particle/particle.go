@@ -1,6 +1,7 @@
 package particle
 
 import (
+	"image"
 	"image/color"
 	"math"
 	"math/rand"
@@ -20,10 +21,18 @@ type Particle struct {
 	UseGravity bool
 }
 
+// textureSpan is how many times its Size a textured particle is drawn
+// across, since the soft edge makes a dot look smaller than its image.
+const textureSpan = 2.0
+
 // System manages a collection of particles.
 type System struct {
 	Particles []*Particle
 	Gravity   float64
+
+	// Texture, when set, is drawn for each particle (tinted with its color,
+	// scaled to its size and blended additively) instead of a flat square.
+	Texture *ebiten.Image
 }
 
 // NewSystem creates a particle system.
@@ -65,6 +74,11 @@ type EmitConfig struct {
 	MaxLifetime    float64
 	MinSize        float32
 	MaxSize        float32
+
+	// ImplodeRadius, when positive, starts particles on a circle of this
+	// radius around (X, Y) aimed at the centre, timed to reach it as they
+	// fade out. The base velocity and spread are ignored.
+	ImplodeRadius float64
 }
 
 func (s *System) Emit(config EmitConfig) {
@@ -76,9 +90,18 @@ func (s *System) Emit(config EmitConfig) {
 		vy := config.BaseVelocityY + math.Sin(angle)*speed
 		size := config.MinSize + rand.Float32()*(config.MaxSize-config.MinSize)
 
+		x, y := config.X, config.Y
+		if config.ImplodeRadius > 0 {
+			dx, dy := math.Cos(angle), math.Sin(angle)
+			x += dx * config.ImplodeRadius
+			y += dy * config.ImplodeRadius
+			vx = -dx * config.ImplodeRadius / lifetime
+			vy = -dy * config.ImplodeRadius / lifetime
+		}
+
 		p := &Particle{
-			X:          config.X,
-			Y:          config.Y,
+			X:          x,
+			Y:          y,
 			VX:         vx,
 			VY:         vy,
 			Life:       lifetime,
@@ -91,8 +114,30 @@ func (s *System) Emit(config EmitConfig) {
 	}
 }
 
+// NewSoftDot creates a white round particle texture of the given radius
+// (px) that fades out from the centre, for System.Texture.
+func NewSoftDot(radius int) *ebiten.Image {
+	size := radius * 2
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dist := math.Hypot(float64(x)+0.5-float64(radius), float64(y)+0.5-float64(radius)) / float64(radius)
+			if dist >= 1 {
+				continue
+			}
+			v := uint8(255 * (1 - dist) * (1 - dist)) // Premultiplied white, bright core
+			img.SetRGBA(x, y, color.RGBA{R: v, G: v, B: v, A: v})
+		}
+	}
+	return ebiten.NewImageFromImage(img)
+}
+
 // Draw renders all particles.
 func (s *System) Draw(screen *ebiten.Image) {
+	if s.Texture != nil {
+		s.drawTextured(screen)
+		return
+	}
 	for _, p := range s.Particles {
 		// Calculate alpha based on remaining life for fade effect
 		alphaFactor := p.Life / p.TotalLife
@@ -115,3 +160,23 @@ func (s *System) Draw(screen *ebiten.Image) {
 		vector.DrawFilledRect(screen, float32(p.X-float64(halfSize)), float32(p.Y-float64(halfSize)), p.Size, p.Size, finalColor, false)
 	}
 }
+
+// drawTextured renders every particle as the system's texture, centred on
+// it, tinted with its color and faded the same way as the squares. Additive
+// blending makes overlapping particles glow.
+func (s *System) drawTextured(screen *ebiten.Image) {
+	texSize := float64(s.Texture.Bounds().Dx())
+	op := &ebiten.DrawImageOptions{Blend: ebiten.BlendLighter}
+	for _, p := range s.Particles {
+		alphaFactor := min(max(p.Life/p.TotalLife, 0), 1)
+		span := float64(p.Size) * textureSpan
+
+		op.GeoM.Reset()
+		op.GeoM.Scale(span/texSize, span/texSize)
+		op.GeoM.Translate(p.X-span/2, p.Y-span/2)
+		op.ColorScale.Reset()
+		op.ColorScale.ScaleWithColor(p.Color)
+		op.ColorScale.ScaleAlpha(float32(alphaFactor))
+		screen.DrawImage(s.Texture, op)
+	}
+}
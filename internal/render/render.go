@@ -2,12 +2,13 @@ package render
 
 import (
 	"fmt"
+	"image"
 	"image/color"
 	"math"
-	"time" // Import time package
+	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/colorm"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
 	"snake-game/internal/assets"
@@ -15,31 +16,138 @@ import (
 )
 
 const (
-	GridCellSize = 20 // Visual size of each grid cell in pixels
+	dangerRadius   = 10  // Enemy head distance (in cells) at which the danger glow starts
+	dangerBands    = 6   // Number of nested bands forming the glow
+	dangerBandSize = 8   // Thickness of each band in pixels
+	dangerMaxAlpha = 110 // Alpha of the outermost band at full intensity
+
+	enemyTintBoost = 1.5 // Brightness of the greyed enemy sprites before their color is applied
+	huntingTintMix = 0.5 // How far a hunting enemy's color is pulled towards huntingTint
+
+	foodBlinkRate = 4.0 // Blinks per second of food about to disappear
+	pathDotAlpha  = 110 // Opacity of the debug dots marking enemy paths
+
+	flashStartRadius = 0.3 // Radius (cells) of a food-eaten ring as it appears
+	flashEndRadius   = 1.2 // Radius (cells) it has grown to as it fades out
+	flashWidth       = 2   // Thickness (px) of the ring
+
+	intentAlpha  = 0.45 // Opacity of the arrow marking an enemy's next move
+	intentLength = 0.5  // Length of that arrow, tip to base (cells)
+	intentWidth  = 0.45 // Width of its base (cells)
+
+	bombPulse     = 0.12 // How much a bomb grows and shrinks as it pulses (fraction of its size)
+	bombPulseRate = 2.0  // Bomb pulses per second
+
+	goldenGlowSize  = 0.8 // Radius of the glow around a golden apple (cells) at its brightest
+	goldenGlowAlpha = 120 // Opacity of that glow at its brightest
+	goldenGlowRate  = 1.5 // Glow pulses per second
+
+	highlightRate  = 1.2 // Pulses per second of the outline around the nearest food
+	highlightWidth = 2   // Thickness (px) of that outline
+
+	effectBarWidth  = 100 // Size (px) of the HUD bar timing the player's speed effect
+	effectBarHeight = 6
+
+	staminaBarWidth  = 100 // Size (px) of each player's stamina bar in the bottom-left corner
+	staminaBarHeight = 6
+
+	clockWarning = 10.0 // Seconds left at which the time attack clock turns red
+
+	afterimageCount   = 3   // Faded copies of the head trailing a speed-boosted snake
+	afterimageSpacing = 0.3 // Distance (in cells) between consecutive afterimages
+	afterimageAlpha   = 0.6 // Opacity of the nearest afterimage relative to the head; each further one is fainter
+
+	ghostAlpha   = 0.45 // Opacity of a snake passing through snakes
+	bestRunAlpha = 0.25 // Opacity of the best run's snake, raced against in practice
+
+	minimapWidth  = 160 // Largest size (px) of the minimap; the board is fitted inside it
+	minimapHeight = 120
 )
 
+// ViewConfig is how the board is laid out on screen. Every drawing function
+// (and the particles the gameplay scene emits) places cells with it.
+type ViewConfig struct {
+	CellSize int // Visual size of each grid cell in pixels; sprites are scaled to fit
+}
+
+// DefaultCellSize is the cell size the sprites are drawn at, the game's
+// original zoom.
+const DefaultCellSize = 20
+
+// CellSizes are the zoom levels selectable from the options.
+var CellSizes = []int{16, DefaultCellSize, 32}
+
+// View is the layout the game is drawn with (the cell size is set from the
+// options).
+var View = ViewConfig{CellSize: DefaultCellSize}
+
+// CellCenter returns the screen position (px) of the middle of the cell at
+// x, y (in cells, possibly between two cells).
+func (v ViewConfig) CellCenter(x, y float64) (float64, float64) {
+	cell := float64(v.CellSize)
+	return (x + 0.5) * cell, (y + 0.5) * cell
+}
+
+// playerHues rotate the green snake sprites (radians) to give each player
+// their own color: player 2 blue, player 3 cyan and player 4 red.
+var playerHues = [game.MaxPlayers]float64{0, 2 * math.Pi / 3, math.Pi / 3, 4 * math.Pi / 3}
+
+// ShowDangerGlow enables the red screen-edge glow that intensifies as enemies
+// close in on the player (set from the options). Turn off to reduce flashing
+// effects.
+var ShowDangerGlow = true
+
+// ShowGrid draws faint grid lines over the board (set from the options).
+var ShowGrid = false
+
+// ShowMinimap draws a scaled-down overview of the whole board in the
+// bottom-right corner (set from the options).
+var ShowMinimap = false
+
+// ShowBestRun replays the best single-player run as a faint snake alongside
+// the live one (set from the options).
+var ShowBestRun = true
+
+// HighlightFood outlines the cell of the food nearest player 1 with a soft
+// pulse, to help find it on a busy board (set from the options).
+var HighlightFood = false
+
+// FlashDuration is how long (seconds of game time) the ring flashing out
+// from eaten food takes to grow and fade; 0 turns the flash off.
+var FlashDuration = 0.3
+
+// ShowPaths draws the path each enemy is following, for debugging the AI.
+// It shows the enemies' next moves too, as ShowIntent does.
+var ShowPaths = false
+
+// ShowIntent marks the cell each enemy moves into next with a faint arrow
+// pointing the way it is going, so players can see it coming (set from the
+// options).
+var ShowIntent = false
+
 var (
-	bgColor            = color.RGBA{R: 15, G: 15, B: 25, A: 255}    // Dark blue-ish background
-	gridColor          = color.RGBA{R: 50, G: 50, B: 70, A: 255}    // Faint grid lines
-	wallColor          = color.RGBA{R: 100, G: 100, B: 120, A: 255} // Color for boundaries
-	playerHeadColor    = color.RGBA{R: 0, G: 200, B: 50, A: 255}
-	playerBodyColor    = color.RGBA{R: 0, G: 255, B: 80, A: 255}
-	enemyHeadColor     = color.RGBA{R: 200, G: 50, B: 0, A: 255}    // Example enemy color
-	enemyBodyColor     = color.RGBA{R: 255, G: 80, B: 0, A: 255}    // Example enemy color
-	foodStandardColor  = color.RGBA{R: 255, G: 0, B: 0, A: 255}     // Red
-	foodSpeedColor     = color.RGBA{R: 255, G: 165, B: 0, A: 255}   // Orange
-	foodSlowColor      = color.RGBA{R: 0, G: 191, B: 255, A: 255}   // Deep Sky Blue
-	foodFlashColor     = color.RGBA{R: 255, G: 255, B: 200, A: 180} // Pale yellow flash
 	speedUpColorShift  = color.RGBA{R: 255, G: 100, B: 100, A: 80}  // Reddish tint overlay
 	slowDownColorShift = color.RGBA{R: 100, G: 100, B: 255, A: 80}  // Bluish tint overlay
+	comboColor         = color.RGBA{R: 255, G: 215, B: 0, A: 255}   // Gold combo multiplier
+	effectBarBgColor   = color.RGBA{R: 60, G: 60, B: 80, A: 200}    // Empty part of the effect timer and stamina bars
+	staminaColor       = color.RGBA{R: 180, G: 255, B: 60, A: 255}  // Player 1's stamina
+	closingZoneColor   = color.RGBA{R: 255, G: 60, B: 40, A: 255}   // Survival band about to be walled off
+	minimapBgColor     = color.RGBA{R: 0, G: 0, B: 0, A: 160}       // Board area of the minimap
+	bestRunTint        = color.RGBA{R: 220, G: 220, B: 255, A: 255} // Pale snake replaying the best run
+	huntingTint        = color.RGBA{R: 255, G: 30, B: 30, A: 255}   // Hunting enemies' colors lean towards this
+	highlightColor     = color.RGBA{R: 255, G: 250, B: 200, A: 255} // Outline around the food nearest the player
+	threatCalmColor    = color.RGBA{R: 255, G: 255, B: 255, A: 255} // HUD threat level as a round starts
+	threatColor        = color.RGBA{R: 255, G: 60, B: 40, A: 255}   // HUD threat level at its highest
 )
 
 // DrawGame renders the entire game state using assets.
+// Colors come from the active theme (see CurrentTheme).
 func DrawGame(screen *ebiten.Image, state game.RenderableState, assets *assets.Manager) {
+	theme := CurrentTheme()
 	// screenWidth, screenHeight := screen.Size() // Remove this line
 
 	// 1. Draw Background
-	if assets.Background != nil {
+	if theme.Sprites && assets.Background != nil {
 		// Basic tiling or stretching - adjust as needed
 		bgWidth, bgHeight := assets.Background.Size()
 		screenWidth, screenHeight := screen.Size()
@@ -57,92 +165,269 @@ func DrawGame(screen *ebiten.Image, state game.RenderableState, assets *assets.M
 			}
 		}
 	} else {
-		screen.Fill(bgColor) // Fallback background color
+		screen.Fill(theme.Background) // Fallback background color
 	}
 
 	// 2. Draw Grid (Optional, can be subtle)
-	// drawGrid(screen, state.GridWidth, state.GridHeight, screenWidth, screenHeight)
+	if ShowGrid {
+		drawGrid(screen, state.GridWidth, state.GridHeight, screen.Bounds().Dx(), screen.Bounds().Dy(), theme)
+	}
 
 	// 3. Draw Walls/Boundaries
-	drawWalls(screen, state.GridWidth, state.GridHeight, assets)
+	drawWalls(screen, state.GridWidth, state.GridHeight, state.Walls, assets, theme)
+	drawObstacles(screen, state.Obstacles, assets, theme)
+	drawSafeZone(screen, state, theme)
 
-	// 4. Draw Food (Iterate over slice)
+	// 4. Draw Food (Iterate over slice), the nearest outlined underneath
+	if HighlightFood && state.NearestFood != nil {
+		drawFoodHighlight(screen, *state.NearestFood, state.GameTime)
+	}
 	// if state.Food != nil { // Old check
 	// 	drawFood(screen, *state.Food)
 	// }
-	for _, food := range state.FoodItems {
+	for i, food := range state.FoodItems {
+		if state.ExpiringFood[i] && int(state.GameTime*foodBlinkRate*2)%2 == 1 {
+			continue // About to vanish: blink
+		}
 		if food != nil { // Check if pointer is valid
-			drawFood(screen, *food, assets) // Dereference pointer to pass game.Food
+			drawFood(screen, *food, assets, theme, state.GameTime) // Dereference pointer to pass game.Food
 		}
 	}
 
 	// 5. Draw Effects (e.g., food flash) - Draw before snakes
 	drawEffects(screen, state)
+	if ShowPaths {
+		drawPaths(screen, state)
+	}
+	if ShowIntent || ShowPaths {
+		drawIntents(screen, state)
+	}
+
+	// The best run goes under every live snake
+	if state.BestRun != nil {
+		drawSnake(screen, *state.BestRun, assets, bestRunTint, 0, bestRunAlpha, state.GameTime)
+	}
 
 	// 6. Draw Enemy Snakes
 	for _, enemy := range state.EnemySnakes {
 		if enemy != nil {
 			// TODO: Pass effect state if enemies have speed effects
-			drawSnake(screen, *enemy, assets)
+			tint := enemy.Color
+			if enemy.Hunting {
+				tint = mixColor(tint, huntingTint, huntingTintMix)
+			}
+			drawSnake(screen, *enemy, assets, tint, 0, 1, state.GameTime)
+		}
+	}
+
+	// 7. Draw Player Snakes (drawn last to be on top, player 1 topmost)
+	for i := len(state.Players) - 1; i >= 0; i-- {
+		tint := color.RGBA{}
+		if i == 0 {
+			tint = theme.PlayerTint
 		}
+		drawSnake(screen, *state.Players[i], assets, tint, playerHues[i%game.MaxPlayers], 1, state.GameTime)
 	}
 
-	// 7. Draw Player Snake (drawn last to be on top)
-	if state.PlayerSnake != nil {
-		drawSnake(screen, *state.PlayerSnake, assets)
+	// 8. Draw danger glow around the screen edges when enemies are near
+	if ShowDangerGlow {
+		drawDangerGlow(screen, state)
 	}
 
-	// 7. Draw HUD (Score, etc.) - To be implemented later
-	drawHUD(screen, state.Score)
+	// 9. Draw HUD (Score, etc.) - To be implemented later
+	drawHUD(screen, state, theme)
+	if ShowMinimap {
+		b := screen.Bounds()
+		drawMinimap(screen, state, theme, image.Rect(b.Dx()-10-minimapWidth, b.Dy()-10-minimapHeight, b.Dx()-10, b.Dy()-10))
+	}
+}
+
+// drawMinimap draws the whole board scaled down into rect: walls, food,
+// enemies and the players in the theme's colors. Cells stay square, so a
+// board of a different shape than rect is centered in it.
+func drawMinimap(screen *ebiten.Image, state game.RenderableState, theme Theme, rect image.Rectangle) {
+	if state.GridWidth <= 0 || state.GridHeight <= 0 {
+		return
+	}
+	cell := min(float32(rect.Dx())/float32(state.GridWidth), float32(rect.Dy())/float32(state.GridHeight))
+	w, h := cell*float32(state.GridWidth), cell*float32(state.GridHeight)
+	x0 := float32(rect.Min.X) + (float32(rect.Dx())-w)/2
+	y0 := float32(rect.Min.Y) + (float32(rect.Dy())-h)/2
+	dot := func(p game.Position, clr color.Color) {
+		size := max(cell, 1) // At least a pixel, however big the board
+		vector.DrawFilledRect(screen, x0+float32(p.X)*cell, y0+float32(p.Y)*cell, size, size, clr, false)
+	}
+
+	vector.DrawFilledRect(screen, x0, y0, w, h, minimapBgColor, false)
+	vector.StrokeRect(screen, x0, y0, w, h, 1, theme.Wall, false)
+	for _, pos := range state.Obstacles {
+		dot(pos, theme.Wall)
+	}
+	zone := state.SafeZone // Survival walls fill the board outside it
+	vector.DrawFilledRect(screen, x0, y0, w, float32(zone.MinY)*cell, theme.Wall, false)
+	vector.DrawFilledRect(screen, x0, y0+float32(zone.MaxY+1)*cell, w, h-float32(zone.MaxY+1)*cell, theme.Wall, false)
+	vector.DrawFilledRect(screen, x0, y0, float32(zone.MinX)*cell, h, theme.Wall, false)
+	vector.DrawFilledRect(screen, x0+float32(zone.MaxX+1)*cell, y0, w-float32(zone.MaxX+1)*cell, h, theme.Wall, false)
+	for _, food := range state.FoodItems {
+		dot(food.Pos, theme.FoodColor(food.Type))
+	}
+	for _, enemy := range state.EnemySnakes {
+		for _, seg := range enemy.Body {
+			dot(seg, theme.Enemy)
+		}
+	}
+	for i := len(state.Players) - 1; i >= 0; i-- {
+		for _, seg := range state.Players[i].Body {
+			dot(seg, theme.Players[i%game.MaxPlayers])
+		}
+	}
 }
 
 // drawGrid draws faint grid lines (optional visual aid)
-func drawGrid(screen *ebiten.Image, gridW, gridH, screenW, screenH int) {
+func drawGrid(screen *ebiten.Image, gridW, gridH, screenW, screenH int, theme Theme) {
 	// Vertical lines
 	for x := 0; x <= gridW; x++ {
-		fx := float32(x * GridCellSize)
-		vector.StrokeLine(screen, fx, 0, fx, float32(screenH), 1, gridColor, false)
+		fx := float32(x * View.CellSize)
+		vector.StrokeLine(screen, fx, 0, fx, float32(screenH), 1, theme.Grid, false)
 	}
 	// Horizontal lines
 	for y := 0; y <= gridH; y++ {
-		fy := float32(y * GridCellSize)
-		vector.StrokeLine(screen, 0, fy, float32(screenW), fy, 1, gridColor, false)
+		fy := float32(y * View.CellSize)
+		vector.StrokeLine(screen, 0, fy, float32(screenW), fy, 1, theme.Grid, false)
 	}
 }
 
 // drawWalls draws the boundaries of the game area.
-func drawWalls(screen *ebiten.Image, gridW, gridH int, assets *assets.Manager) {
+func drawWalls(screen *ebiten.Image, gridW, gridH int, walls game.WallConfig, assets *assets.Manager, theme Theme) {
 	// Use wall sprite if available, otherwise fallback to colored rects
 	if assets.Wall != nil {
 		// TODO: Implement drawing walls using the assets.Wall sprite
 		// This might involve drawing tiles or stretching the sprite.
 		// For now, fallback to simple rects.
-		drawWallRects(screen, gridW, gridH)
+		drawWallRects(screen, gridW, gridH, walls, theme.Wall)
 	} else {
-		drawWallRects(screen, gridW, gridH)
+		drawWallRects(screen, gridW, gridH, walls, theme.Wall)
 	}
 }
 
 // drawWallRects draws simple rectangles for walls (fallback).
-func drawWallRects(screen *ebiten.Image, gridW, gridH int) {
+func drawWallRects(screen *ebiten.Image, gridW, gridH int, walls game.WallConfig, clr color.Color) {
 	thickness := float32(2)
-	w := float32(gridW * GridCellSize)
-	h := float32(gridH * GridCellSize)
-	vector.DrawFilledRect(screen, 0, 0, w, thickness, wallColor, false)
-	vector.DrawFilledRect(screen, 0, h-thickness, w, thickness, wallColor, false)
-	vector.DrawFilledRect(screen, 0, 0, thickness, h, wallColor, false)
-	vector.DrawFilledRect(screen, w-thickness, 0, thickness, h, wallColor, false)
+	w := float32(gridW * View.CellSize)
+	h := float32(gridH * View.CellSize)
+	// Edges that wrap around are open, so they get no wall
+	if !walls.WrapTop {
+		vector.DrawFilledRect(screen, 0, 0, w, thickness, clr, false)
+	}
+	if !walls.WrapBottom {
+		vector.DrawFilledRect(screen, 0, h-thickness, w, thickness, clr, false)
+	}
+	if !walls.WrapLeft {
+		vector.DrawFilledRect(screen, 0, 0, thickness, h, clr, false)
+	}
+	if !walls.WrapRight {
+		vector.DrawFilledRect(screen, w-thickness, 0, thickness, h, clr, false)
+	}
+}
+
+// drawSafeZone fills the cells the survival walls have closed off, and makes
+// the band they are about to close over pulse red as a warning.
+func drawSafeZone(screen *ebiten.Image, state game.RenderableState, theme Theme) {
+	board := game.Bounds{MaxX: state.GridWidth - 1, MaxY: state.GridHeight - 1}
+	fillBetween(screen, board, state.SafeZone, theme.Wall)
+	if state.NextSafeZone != state.SafeZone {
+		pulse := 0.5 + 0.5*math.Sin(state.GameTime*2*math.Pi*2) // Two pulses a second
+		warn := closingZoneColor
+		warn.A = uint8(60 + 100*pulse)
+		fillBetween(screen, state.SafeZone, state.NextSafeZone, warn)
+	}
+}
+
+// fillBetween fills the cells inside outer but not inside inner (a frame,
+// since inner sits within outer).
+func fillBetween(screen *ebiten.Image, outer, inner game.Bounds, clr color.Color) {
+	cell := float32(View.CellSize)
+	rect := func(minX, minY, maxX, maxY int) {
+		if maxX >= minX && maxY >= minY {
+			vector.DrawFilledRect(screen, float32(minX)*cell, float32(minY)*cell,
+				float32(maxX-minX+1)*cell, float32(maxY-minY+1)*cell, clr, false)
+		}
+	}
+	rect(outer.MinX, outer.MinY, outer.MaxX, inner.MinY-1) // Top
+	rect(outer.MinX, inner.MaxY+1, outer.MaxX, outer.MaxY) // Bottom
+	rect(outer.MinX, inner.MinY, inner.MinX-1, inner.MaxY) // Left
+	rect(inner.MaxX+1, inner.MinY, outer.MaxX, inner.MaxY) // Right
+}
+
+// drawPaths marks each enemy's planned path with small translucent dots in
+// the enemy's color.
+func drawPaths(screen *ebiten.Image, state game.RenderableState) {
+	cell := float32(View.CellSize)
+	for i, path := range state.EnemyPaths {
+		clr := state.EnemySnakes[i].Color
+		clr.A = pathDotAlpha
+		for _, p := range path {
+			cx, cy := View.CellCenter(float64(p.X), float64(p.Y))
+			vector.DrawFilledCircle(screen, float32(cx), float32(cy), cell*0.15, clr, true)
+		}
+	}
+}
+
+// drawIntents draws a faint arrow in the enemy's color in the cell ahead of
+// each enemy's head, pointing the way the enemy will move next. A move out
+// across a wrapping edge isn't marked.
+func drawIntents(screen *ebiten.Image, state game.RenderableState) {
+	cell := float64(View.CellSize)
+	for i, enemy := range state.EnemySnakes {
+		if enemy == nil || len(enemy.Body) == 0 {
+			continue
+		}
+		angle := headAngle(state.EnemyIntents[i])
+		dx, dy := math.Cos(angle), math.Sin(angle)
+		x, y := float64(enemy.Body[0].X)+math.Round(dx), float64(enemy.Body[0].Y)+math.Round(dy)
+		if x < 0 || y < 0 || x >= float64(state.GridWidth) || y >= float64(state.GridHeight) {
+			continue
+		}
+		cx, cy := View.CellCenter(x, y)
+		half, side := intentLength/2*cell, intentWidth/2*cell // Centered on the cell: tip ahead, base behind
+		var path vector.Path
+		path.MoveTo(float32(cx+dx*half), float32(cy+dy*half))
+		path.LineTo(float32(cx-dx*half-dy*side), float32(cy-dy*half+dx*side))
+		path.LineTo(float32(cx-dx*half+dy*side), float32(cy-dy*half-dx*side))
+		path.Close()
+		fillPath(screen, &path, fade(enemy.Color, intentAlpha))
+	}
+}
+
+// drawObstacles draws interior wall cells with the Wall sprite, falling back
+// to plain rectangles when the sprite is missing or the theme doesn't use it.
+func drawObstacles(screen *ebiten.Image, obstacles []game.Position, assets *assets.Manager, theme Theme) {
+	cell := View.CellSize
+	for _, pos := range obstacles {
+		if !theme.Sprites || assets.Wall == nil {
+			x := float32(pos.X * cell)
+			y := float32(pos.Y * cell)
+			vector.DrawFilledRect(screen, x, y, float32(cell), float32(cell), theme.Wall, false)
+			continue
+		}
+		imgW, imgH := assets.Wall.Size()
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(float64(cell)/float64(imgW), float64(cell)/float64(imgH))
+		op.GeoM.Translate(float64(pos.X*cell), float64(pos.Y*cell))
+		screen.DrawImage(assets.Wall, op)
+	}
 }
 
 // drawSnake draws a single snake using sprites with interpolation and effects.
-func drawSnake(screen *ebiten.Image, s game.Snake, assets *assets.Manager) {
-	if len(s.Body) == 0 || len(s.PrevBody) == 0 || len(s.Body) != len(s.PrevBody) || assets.SnakeBody == nil || assets.SnakeHead == nil {
-		// log.Printf("DrawSnake skip: BodyLen=%d, PrevBodyLen=%d, BodyAsset=%v, HeadAsset=%v", len(s.Body), len(s.PrevBody), assets.SnakeBody, assets.SnakeHead)
-		return // Cannot draw without assets or consistent body/prevBody
+// tint recolors the sprites (zero keeps their own colors) and hue rotates them
+// (in radians) to tell snakes apart; alpha is the snake's opacity. Without
+// the head or body sprite the snake is drawn as shapes (see drawShapeSnake).
+func drawSnake(screen *ebiten.Image, s game.Snake, assets *assets.Manager, tint color.RGBA, hue, alpha, gameTime float64) {
+	if len(s.Body) == 0 || len(s.PrevBody) == 0 || len(s.Body) != len(s.PrevBody) {
+		// log.Printf("DrawSnake skip: BodyLen=%d, PrevBodyLen=%d", len(s.Body), len(s.PrevBody))
+		return // Cannot draw without consistent body/prevBody
 	}
 
-	bodyW, bodyH := assets.SnakeBody.Size()
-	headW, headH := assets.SnakeHead.Size()
 	progress := s.MoveProgress // How far we are into the current move (0.0 to < 1.0)
 
 	// Helper function for linear interpolation
@@ -152,7 +437,7 @@ func drawSnake(screen *ebiten.Image, s game.Snake, assets *assets.Manager) {
 
 	// Check for active speed effect
 	var speedEffectColor color.Color = nil
-	if !s.SpeedEffectEndTime.IsZero() && time.Now().Before(s.SpeedEffectEndTime) {
+	if s.SpeedEffectLeft > 0 {
 		if s.SpeedFactor > 1.0 {
 			speedEffectColor = speedUpColorShift
 		} else if s.SpeedFactor < 1.0 {
@@ -160,32 +445,52 @@ func drawSnake(screen *ebiten.Image, s game.Snake, assets *assets.Manager) {
 		}
 	}
 
+	// Effects drawn over the snake's own colors
+	var effects colorm.ColorM
+	if speedEffectColor != nil {
+		effects.ScaleWithColor(speedEffectColor) // Tint on top of the hue shift
+	}
+	if s.Ghosting(gameTime) {
+		effects.Scale(1, 1, 1, ghostAlpha) // See-through while passing through snakes
+	}
+	if alpha < 1 {
+		effects.Scale(1, 1, 1, alpha)
+	}
+
+	if assets.SnakeBody == nil || assets.SnakeHead == nil {
+		drawShapeSnake(screen, s, snakeColorM(tint, hue), effects)
+		return
+	}
+	bodyW, bodyH := assets.SnakeBody.Size()
+	headW, headH := assets.SnakeHead.Size()
+
+	if (s.SpeedEffectLeft > 0 && s.SpeedFactor > 1.0) || s.Boosting {
+		drawAfterimages(screen, s, assets.SnakeHead, tint, hue, alpha)
+	}
+
 	// Draw segments (Body and Head)
 	for i := 0; i < len(s.Body); i++ {
 		segment := s.Body[i]
 		prevSegmentPos := s.PrevBody[i]
 		visX := lerp(float64(prevSegmentPos.X), float64(segment.X), progress)
 		visY := lerp(float64(prevSegmentPos.Y), float64(segment.Y), progress)
+		if teleported(prevSegmentPos, segment) {
+			visX, visY = float64(segment.X), float64(segment.Y) // Don't slide across the board
+		}
 
 		var img *ebiten.Image
 		var imgW, imgH int
 		var angle float64 = 0
-		op := &ebiten.DrawImageOptions{}
+		op := &colorm.DrawImageOptions{}
+		cm := snakeColorM(tint, hue)
 
 		if i == 0 { // Head
 			img = assets.SnakeHead
-			imgW, imgH = headW, headH // Already got size earlier
-			// Calculate head rotation based on logical direction
-			switch s.Direction {
-			case game.DirUp:
-				angle = -math.Pi / 2
-			case game.DirDown:
-				angle = math.Pi / 2
-			case game.DirLeft:
-				angle = math.Pi
-			case game.DirRight:
-				angle = 0
+			if s.ShieldCount > 0 {
+				cm.Translate(0, 0.25, 0.35, 0) // Turquoise glow while shielded
 			}
+			imgW, imgH = headW, headH // Already got size earlier
+			angle = turnAngle(s.PrevDirection, s.Direction, progress)
 		} else { // Body
 			img = assets.SnakeBody
 			imgW, imgH = bodyW, bodyH // Already got size earlier
@@ -194,8 +499,29 @@ func drawSnake(screen *ebiten.Image, s game.Snake, assets *assets.Manager) {
 			prevSegmentInFront := s.PrevBody[i-1]
 			visFrontX := lerp(float64(prevSegmentInFront.X), float64(segmentInFront.X), progress)
 			visFrontY := lerp(float64(prevSegmentInFront.Y), float64(segmentInFront.Y), progress)
+			if teleported(prevSegmentInFront, segmentInFront) {
+				visFrontX, visFrontY = float64(segmentInFront.X), float64(segmentInFront.Y)
+			}
 			dx := visFrontX - visX
 			dy := visFrontY - visY
+			if math.Abs(dx) > 1.5 || math.Abs(dy) > 1.5 {
+				// The segment in front has gone through a portal; keep facing
+				// the way this segment is moving
+				dx = float64(segment.X - prevSegmentPos.X)
+				dy = float64(segment.Y - prevSegmentPos.Y)
+			}
+			if math.Abs(dx) < 0.01 && math.Abs(dy) < 0.01 {
+				// A freshly grown tail overlaps the segment in front at the
+				// start of the step; face where that segment is heading.
+				dx = float64(segmentInFront.X - segment.X)
+				dy = float64(segmentInFront.Y - segment.Y)
+			}
+			for j := i - 2; j >= 0 && dx == 0 && dy == 0; j-- {
+				// Still overlapping after several growth steps in a row:
+				// face the nearest segment further up that has moved on
+				dx = float64(s.Body[j].X - segment.X)
+				dy = float64(s.Body[j].Y - segment.Y)
+			}
 			if math.Abs(dx) < 0.01 {
 				angle = math.Pi / 2
 			} else if math.Abs(dy) < 0.01 {
@@ -203,79 +529,441 @@ func drawSnake(screen *ebiten.Image, s game.Snake, assets *assets.Manager) {
 			} else {
 				angle = math.Atan2(dy, dx) /* Optional: Snap? */
 			}
+			if i == len(s.Body)-1 && assets.SnakeTail != nil {
+				// The tail points away from the segment in front, following
+				// it smoothly rather than in quarter turns
+				img = assets.SnakeTail
+				imgW, imgH = assets.SnakeTail.Size()
+				angle = math.Atan2(dy, dx)
+			}
+			// Where the body turns, bend it with the corner sprite
+			if assets.SnakeCorner != nil && i < len(s.Body)-1 {
+				if cornerRot, ok := cornerAngle(segmentInFront, segment, s.Body[i+1]); ok {
+					img = assets.SnakeCorner
+					imgW, imgH = assets.SnakeCorner.Size()
+					angle = cornerRot
+				}
+			}
 		}
 
-		// Common Drawing Logic
-		tx := visX*float64(GridCellSize) + float64(GridCellSize-imgW)/2.0
-		ty := visY*float64(GridCellSize) + float64(GridCellSize-imgH)/2.0
-		centerX := float64(imgW) / 2.0
-		centerY := float64(imgH) / 2.0
-		op.GeoM.Translate(-centerX, -centerY)
+		// Common Drawing Logic: fit the sprite to the cell and turn it
+		// about its middle
+		op.GeoM.Translate(-float64(imgW)/2.0, -float64(imgH)/2.0)
+		op.GeoM.Scale(float64(View.CellSize)/float64(imgW), float64(View.CellSize)/float64(imgH))
 		op.GeoM.Rotate(angle)
-		op.GeoM.Translate(centerX, centerY)
-		op.GeoM.Translate(tx, ty)
+		op.GeoM.Translate(View.CellCenter(visX, visY))
 
-		// Apply speed effect color modification if active
-		if speedEffectColor != nil {
-			op.ColorScale.ScaleWithColor(speedEffectColor) // Use ColorScale for tinting
-		}
+		cm.Concat(effects)
+		colorm.DrawImage(screen, img, cm, op)
+	}
+}
 
-		screen.DrawImage(img, op)
+// snakeColorM returns the color matrix giving a snake its colors: the green
+// sprites rotated by hue, or recolored in tint (an enemy's own color or the
+// theme's player tint).
+func snakeColorM(tint color.RGBA, hue float64) colorm.ColorM {
+	var cm colorm.ColorM
+	cm.RotateHue(hue)
+	if tint != (color.RGBA{}) {
+		cm.ChangeHSV(0, 0, enemyTintBoost) // Grey, brightened so the tint stays vivid
+		cm.ScaleWithColor(tint)
 	}
+	return cm
 }
 
-// drawFood draws a food item using sprites.
-func drawFood(screen *ebiten.Image, f game.Food, assets *assets.Manager) {
-	var img *ebiten.Image
-	switch f.Type {
-	case game.FoodTypeStandard:
-		img = assets.FoodStandard
-	case game.FoodTypeSpeedUp:
-		img = assets.FoodSpeedUp
-	case game.FoodTypeSlowDown:
-		img = assets.FoodSlowDown
+// headAngle returns the rotation of the head sprite (which faces right) for
+// a direction of travel.
+func headAngle(dir game.Direction) float64 {
+	switch dir {
+	case game.DirUp:
+		return -math.Pi / 2
+	case game.DirDown:
+		return math.Pi / 2
+	case game.DirLeft:
+		return math.Pi
 	default:
+		return 0
+	}
+}
+
+// cornerAngle returns the rotation of the corner sprite (which joins the
+// cells to its left and below) for a segment at mid whose neighbours are at
+// front and back. It reports false unless the three cells form a bend.
+func cornerAngle(front, mid, back game.Position) (float64, bool) {
+	ax, ay := front.X-mid.X, front.Y-mid.Y
+	bx, by := back.X-mid.X, back.Y-mid.Y
+	if abs(ax)+abs(ay) != 1 || abs(bx)+abs(by) != 1 || ax*bx+ay*by != 0 {
+		return 0, false // Straight, overlapping (growing) or split by a portal
+	}
+	// Turn the sprite's arms (left and down) a quarter clockwise at a time
+	// until they point at the two neighbours.
+	lx, ly, dx, dy := -1, 0, 0, 1
+	for k := 0; k < 4; k++ {
+		if (lx == ax && ly == ay && dx == bx && dy == by) || (lx == bx && ly == by && dx == ax && dy == ay) {
+			return float64(k) * math.Pi / 2, true
+		}
+		lx, ly = -ly, lx
+		dx, dy = -dy, dx
+	}
+	return 0, false
+}
+
+// turnAngle returns the head's angle t of the way (0..1) through turning
+// from one direction to another, taking the shorter way round.
+func turnAngle(from, to game.Direction, t float64) float64 {
+	start, end := headAngle(from), headAngle(to)
+	delta := math.Remainder(end-start, 2*math.Pi) // In [-Pi, Pi]
+	return start + delta*t
+}
+
+// drawAfterimages draws fading copies of the head at points it passed a
+// moment ago, giving a boosted snake a motion blur. The points are taken
+// along the path through PrevBody, so the trail follows corners. Purely
+// visual: the logic never sees them.
+func drawAfterimages(screen *ebiten.Image, s game.Snake, head *ebiten.Image, tint color.RGBA, hue, alpha float64) {
+	if len(s.PrevBody) < 2 {
+		return
+	}
+	w, h := head.Size()
+	for k := 1; k <= afterimageCount; k++ {
+		// Walk back along the path: t in [0, 1) is on the current move,
+		// t < 0 on the one before it.
+		t := s.MoveProgress - float64(k)*afterimageSpacing
+		from, to := s.PrevBody[0], s.Body[0]
+		if t < 0 {
+			from, to = s.PrevBody[1], s.PrevBody[0]
+			t++
+		}
+		if t < 0 || teleported(from, to) {
+			break
+		}
+		x := float64(from.X) + float64(to.X-from.X)*t
+		y := float64(from.Y) + float64(to.Y-from.Y)*t
+
+		op := &colorm.DrawImageOptions{}
+		op.GeoM.Translate(-float64(w)/2, -float64(h)/2)
+		op.GeoM.Scale(float64(View.CellSize)/float64(w), float64(View.CellSize)/float64(h))
+		op.GeoM.Rotate(headAngle(s.Direction))
+		op.GeoM.Translate(View.CellCenter(x, y))
+		cm := snakeColorM(tint, hue)
+		cm.ScaleWithColor(speedUpColorShift)
+		cm.Scale(1, 1, 1, alpha*afterimageAlpha*float64(afterimageCount+1-k)/float64(afterimageCount))
+		colorm.DrawImage(screen, head, cm, op)
+	}
+}
+
+// teleported reports whether a segment jumped (through a portal or a
+// wrapping edge) rather than moving to a neighbouring cell.
+func teleported(from, to game.Position) bool {
+	return abs(to.X-from.X)+abs(to.Y-from.Y) > 1
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// mixColor blends a towards b by t (0 keeps a, 1 gives b).
+func mixColor(a, b color.RGBA, t float64) color.RGBA {
+	mix := func(x, y uint8) uint8 { return uint8(float64(x) + (float64(y)-float64(x))*t) }
+	return color.RGBA{R: mix(a.R, b.R), G: mix(a.G, b.G), B: mix(a.B, b.B), A: mix(a.A, b.A)}
+}
+
+// drawFood draws a food item using sprites. Animated sprites start from
+// their first frame when the food appears. Bombs pulse so they stand out
+// from food, and golden apples glow.
+func drawFood(screen *ebiten.Image, f game.Food, assets *assets.Manager, theme Theme, gameTime float64) {
+	anim, ok := foodAnimation(assets, f.Type)
+	if !ok {
 		return // Don't draw unknown food types
 	}
+	if f.Type == game.FoodTypeGolden {
+		drawGoldenGlow(screen, f.Pos, theme.FoodColor(game.FoodTypeGolden), gameTime)
+	}
+	var img *ebiten.Image
+	if anim != nil {
+		img = anim.Frame(gameTime - f.SpawnTime)
+	}
+
+	scale := 1.0
+	if f.Type == game.FoodTypeBomb {
+		scale = 1 + bombPulse*math.Sin(gameTime*2*math.Pi*bombPulseRate)
+	}
 
 	if img == nil {
-		return // Don't draw if asset is missing
+		// Optional sprite missing: fall back to a disc in the food's color
+		cx, cy := View.CellCenter(float64(f.Pos.X), float64(f.Pos.Y))
+		drawDisc(screen, float32(cx), float32(cy), float32(View.CellSize)*0.4*float32(scale), theme.FoodColor(f.Type))
+		return
 	}
 
 	imgW, imgH := img.Size()
 	op := &ebiten.DrawImageOptions{}
-	// Center the sprite
-	tx := float64(f.Pos.X*GridCellSize) + float64(GridCellSize-imgW)/2.0
-	ty := float64(f.Pos.Y*GridCellSize) + float64(GridCellSize-imgH)/2.0
-	op.GeoM.Translate(tx, ty)
+	// Center the sprite, fitted to the cell (scaled about its middle)
+	op.GeoM.Translate(-float64(imgW)/2, -float64(imgH)/2)
+	op.GeoM.Scale(scale*float64(View.CellSize)/float64(imgW), scale*float64(View.CellSize)/float64(imgH))
+	op.GeoM.Translate(View.CellCenter(float64(f.Pos.X), float64(f.Pos.Y)))
 
 	screen.DrawImage(img, op)
 }
 
+// drawFoodHighlight draws a soft, pulsing outline around the cell at pos,
+// breathing in and out a little so it catches the eye without flashing.
+func drawFoodHighlight(screen *ebiten.Image, pos game.Position, gameTime float64) {
+	pulse := 0.5 + 0.5*math.Sin(gameTime*2*math.Pi*highlightRate)
+	clr := fade(highlightColor, 0.35+0.45*pulse)
+	cell := float32(View.CellSize)
+	inset := float32(1.5 * (1 - pulse)) // Grows to the cell's edge at the pulse's peak
+	x := float32(pos.X)*cell + inset
+	y := float32(pos.Y)*cell + inset
+	size, w := cell-2*inset, float32(highlightWidth)
+	// Four sides that don't overlap, so the corners are no brighter
+	vector.DrawFilledRect(screen, x, y, size, w, clr, true)              // Top
+	vector.DrawFilledRect(screen, x, y+size-w, size, w, clr, true)       // Bottom
+	vector.DrawFilledRect(screen, x, y+w, w, size-2*w, clr, true)        // Left
+	vector.DrawFilledRect(screen, x+size-w, y+w, w, size-2*w, clr, true) // Right
+}
+
+// foodAnimation returns the sprite of a food type, nil if its optional sprite
+// is missing. It reports false for an unknown type.
+func foodAnimation(m *assets.Manager, t game.FoodType) (*assets.Animation, bool) {
+	switch t {
+	case game.FoodTypeStandard:
+		return m.FoodStandard, true
+	case game.FoodTypeSpeedUp:
+		return m.FoodSpeedUp, true
+	case game.FoodTypeSlowDown:
+		return m.FoodSlowDown, true
+	case game.FoodTypeTeleport:
+		return m.FoodTeleport, true
+	case game.FoodTypeShrink:
+		return m.FoodShrink, true
+	case game.FoodTypeShield:
+		return m.FoodShield, true
+	case game.FoodTypeBomb:
+		return m.FoodBomb, true
+	case game.FoodTypeGolden:
+		return m.FoodGolden, true
+	case game.FoodTypeGhost:
+		return m.FoodGhost, true
+	}
+	return nil, false
+}
+
+// drawGoldenGlow draws the pulsing halo behind a golden apple.
+func drawGoldenGlow(screen *ebiten.Image, pos game.Position, glow color.RGBA, gameTime float64) {
+	pulse := 0.5 + 0.5*math.Sin(gameTime*2*math.Pi*goldenGlowRate)
+	glow.A = uint8(goldenGlowAlpha * (0.4 + 0.6*pulse))
+	cx, cy := View.CellCenter(float64(pos.X), float64(pos.Y))
+	radius := float32(View.CellSize) * goldenGlowSize * float32(0.75+0.25*pulse)
+	vector.DrawFilledCircle(screen, float32(cx), float32(cy), radius, glow, true)
+}
+
+// FoodColor returns the signature color of a food type in the active theme,
+// e.g. for effects.
+func FoodColor(t game.FoodType) color.Color {
+	return CurrentTheme().FoodColor(t)
+}
+
 // drawEffects renders transient visual effects.
 func drawEffects(screen *ebiten.Image, state game.RenderableState) {
-	// Food Eaten Flash - REMOVED
-	/*
-		if state.FoodEatenPos != nil {
-			// Simple square flash effect
-			fx := float32(state.FoodEatenPos.X * GridCellSize)
-			fy := float32(state.FoodEatenPos.Y * GridCellSize)
-			size := float32(GridCellSize) // Flash covers the cell
-			vector.DrawFilledRect(screen, fx, fy, size, size, foodFlashColor, false)
+	// Food eaten flash: a ring growing out of the cell and fading, timed on
+	// game time so it freezes with the game and plays out the same at any
+	// frame rate
+	for _, flash := range state.Flashes {
+		if FlashDuration <= 0 {
+			break
+		}
+		t := (state.GameTime - flash.Time) / FlashDuration
+		if t < 0 || t >= 1 {
+			continue
 		}
-	*/
+		cell := float64(View.CellSize)
+		cx, cy := View.CellCenter(float64(flash.Pos.X), float64(flash.Pos.Y))
+		radius := (flashStartRadius + (flashEndRadius-flashStartRadius)*t) * cell
+		vector.StrokeCircle(screen, float32(cx), float32(cy), float32(radius), flashWidth, fade(flash.Color, 1-t), true)
+	}
 
-	// TODO: Add spawning effects
+	// Spawn bursts are particles, emitted by the gameplay scene from the game's events
 	// TODO: Add collision effects
 }
 
-// drawHUD function renders the Heads-Up Display (Score, etc.)
-func drawHUD(screen *ebiten.Image, score int /*, other hud data */) {
+// nearestEnemyDistance returns the Manhattan distance from player 1's head
+// to the closest enemy head, or false if there is no player or enemy.
+func nearestEnemyDistance(state game.RenderableState) (int, bool) {
+	if len(state.Players) == 0 || len(state.Players[0].Body) == 0 {
+		return 0, false
+	}
+	playerHead := state.Players[0].Body[0]
+	minDist := -1
+	for _, enemy := range state.EnemySnakes {
+		if enemy == nil || len(enemy.Body) == 0 {
+			continue
+		}
+		head := enemy.Body[0]
+		dist := int(math.Abs(float64(head.X-playerHead.X)) + math.Abs(float64(head.Y-playerHead.Y)))
+		if minDist < 0 || dist < minDist {
+			minDist = dist
+		}
+	}
+	return minDist, minDist >= 0
+}
+
+// drawDangerGlow draws a red vignette whose strength scales with enemy proximity.
+func drawDangerGlow(screen *ebiten.Image, state game.RenderableState) {
+	dist, ok := nearestEnemyDistance(state)
+	if !ok || dist >= dangerRadius {
+		return
+	}
+	intensity := 1.0 - float64(dist)/float64(dangerRadius) // 0 (far) .. 1 (touching)
+
+	bounds := screen.Bounds()
+	w := float32(bounds.Dx())
+	h := float32(bounds.Dy())
+	band := float32(dangerBandSize)
+	for i := 0; i < dangerBands; i++ {
+		// Outer bands are strongest, fading towards the centre
+		alpha := float64(dangerMaxAlpha) * intensity * (1.0 - float64(i)/float64(dangerBands))
+		clr := color.NRGBA{R: 200, G: 0, B: 0, A: uint8(alpha)}
+		inset := float32(i) * band
+		vector.DrawFilledRect(screen, inset, inset, w-2*inset, band, clr, false)                    // Top
+		vector.DrawFilledRect(screen, inset, h-inset-band, w-2*inset, band, clr, false)             // Bottom
+		vector.DrawFilledRect(screen, inset, inset+band, band, h-2*inset-2*band, clr, false)        // Left
+		vector.DrawFilledRect(screen, w-inset-band, inset+band, band, h-2*inset-2*band, clr, false) // Right
+	}
+}
+
+// drawHUD function renders the Heads-Up Display (Score, combo, etc.)
+func drawHUD(screen *ebiten.Image, state game.RenderableState, theme Theme) {
+	multiplayer := len(state.Players) > 1
+	score := 0
+	if len(state.Scores) > 0 {
+		score = state.Scores[0]
+	}
 	scoreStr := fmt.Sprintf("Score: %d", score)
+	if multiplayer {
+		scoreStr = fmt.Sprintf("P1: %d", score)
+	}
+
+	// Simple text rendering at top-left
+	DrawText(screen, scoreStr, 10, 10, BodyFontSize, TextColor)
+
+	// The other players' scores follow, each in their snake's color
+	scoreW, _ := MeasureText(scoreStr, BodyFontSize)
+	otherX := 10 + int(scoreW) + 16
+	for i := 1; i < len(state.Scores); i++ {
+		str := fmt.Sprintf("P%d: %d", i+1, state.Scores[i])
+		DrawText(screen, str, otherX, 10, BodyFontSize, theme.Players[i%game.MaxPlayers])
+		strW, _ := MeasureText(str, BodyFontSize)
+		otherX += int(strW) + 16
+	}
+
+	// Shields held, below the score
+	var shields []string
+	for i, player := range state.Players {
+		if player.ShieldCount == 0 {
+			continue
+		}
+		label := fmt.Sprintf("Shield x%d", player.ShieldCount)
+		if multiplayer {
+			label = fmt.Sprintf("P%d %s", i+1, label)
+		}
+		shields = append(shields, label)
+	}
+	if len(shields) > 0 {
+		DrawText(screen, strings.Join(shields, "  "), 10, 30, BodyFontSize, theme.FoodColor(game.FoodTypeShield))
+	}
+
+	// Brief notice after the player eats shrink food
+	if state.ShrunkBy > 0 {
+		DrawText(screen, fmt.Sprintf("Shrunk -%d", state.ShrunkBy), 10, 50, BodyFontSize, theme.FoodColor(game.FoodTypeShrink))
+	}
+
+	// Combo multiplier next to the score while a combo is running, then the
+	// player's length (single player, where it counts towards the score)
+	x := 10 + int(scoreW) + 12
+	if state.ComboMultiplier > 1 {
+		comboStr := fmt.Sprintf("x%d", state.ComboMultiplier)
+		DrawText(screen, comboStr, x, 10, BodyFontSize, comboColor)
+		comboW, _ := MeasureText(comboStr, BodyFontSize)
+		x += int(comboW) + 12
+	}
+	if !multiplayer {
+		DrawText(screen, fmt.Sprintf("Length: %d", state.PlayerLength), x, 10, BodyFontSize, TextColor)
+	}
+
+	// Difficulty in the top-right corner, flagged in practice
+	diffStr := state.Difficulty.String()
+	if state.Mode == game.ModePractice {
+		diffStr = fmt.Sprintf("Practice (%s)", diffStr)
+	}
+	diffW, _ := MeasureText(diffStr, BodyFontSize)
+	DrawText(screen, diffStr, screen.Bounds().Dx()-10-int(diffW), 10, BodyFontSize, TextColor)
+
+	// Threat level to its left, reddening as enemies speed up
+	if state.Threat > 0 {
+		threatStr := fmt.Sprintf("Threat %d%%", int(state.Threat*100))
+		threatW, _ := MeasureText(threatStr, BodyFontSize)
+		DrawText(screen, threatStr, screen.Bounds().Dx()-10-int(diffW)-16-int(threatW), 10, BodyFontSize, mixColor(threatCalmColor, threatColor, state.Threat))
+	}
+
+	drawEffectTimer(screen, state, theme)
+	drawStamina(screen, state, theme)
+	if state.Mode == game.ModeTimeAttack {
+		drawClock(screen, state)
+	}
+
+	// Ghost time left, under the effect timer
+	if state.GhostTimeLeft > 0 {
+		ghostStr := fmt.Sprintf("Ghost %.1fs", state.GhostTimeLeft)
+		ghostW, _ := MeasureText(ghostStr, BodyFontSize)
+		DrawText(screen, ghostStr, screen.Bounds().Dx()-10-int(ghostW), 44, BodyFontSize, theme.FoodColor(game.FoodTypeGhost))
+	}
+}
+
+// drawClock shows the time left in a time attack round, large and centered
+// at the top, in red for the last clockWarning seconds.
+func drawClock(screen *ebiten.Image, state game.RenderableState) {
+	secs := int(math.Ceil(state.TimeRemaining))
+	clockStr := fmt.Sprintf("%d:%02d", secs/60, secs%60)
+	var clr color.Color = TextColor
+	if state.TimeRemaining < clockWarning {
+		clr = closingZoneColor
+	}
+	DrawCentered(screen, clockStr, screen.Bounds().Dx()/2, 6, TitleFontSize, clr)
+}
 
-	// Simple text rendering at top-left. Improve with fonts later.
-	// Use ebitenutil which we should have imported.
-	ebitenutil.DebugPrintAt(screen, scoreStr, 10, 10)
+// drawStamina draws each player's boost stamina as a bar in the bottom-left
+// corner, the other players' in their colors beside player 1's.
+func drawStamina(screen *ebiten.Image, state game.RenderableState, theme Theme) {
+	y := float32(screen.Bounds().Dy() - 10 - staminaBarHeight)
+	for i, player := range state.Players {
+		var clr color.Color = staminaColor
+		if i > 0 {
+			clr = theme.Players[i%game.MaxPlayers]
+		}
+		x := float32(10 + i*(staminaBarWidth+16))
+		vector.DrawFilledRect(screen, x, y, staminaBarWidth, staminaBarHeight, effectBarBgColor, false)
+		vector.DrawFilledRect(screen, x, y, staminaBarWidth*float32(player.Stamina), staminaBarHeight, clr, false)
+	}
+}
 
-	// TODO: Add rendering for speed effect duration if needed
+// drawEffectTimer draws a bar under the difficulty that shrinks as the
+// player's speed effect runs out, in the color of the food that caused it.
+// Nothing is drawn while no effect is active.
+func drawEffectTimer(screen *ebiten.Image, state game.RenderableState, theme Theme) {
+	if state.SpeedEffectDuration <= 0 || state.SpeedEffectTotal <= 0 || state.PlayerSpeedFactor == 1.0 {
+		return
+	}
+	clr := theme.FoodColor(game.FoodTypeSpeedUp)
+	if state.PlayerSpeedFactor < 1.0 {
+		clr = theme.FoodColor(game.FoodTypeSlowDown)
+	}
+	left := float32(min(state.SpeedEffectDuration.Seconds()/state.SpeedEffectTotal.Seconds(), 1))
+	x := float32(screen.Bounds().Dx() - 10 - effectBarWidth)
+	vector.DrawFilledRect(screen, x, 32, effectBarWidth, effectBarHeight, effectBarBgColor, false)
+	vector.DrawFilledRect(screen, x, 32, effectBarWidth*left, effectBarHeight, clr, false)
 }
@@ -0,0 +1,182 @@
+package render
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"snake-game/internal/assets"
+	"snake-game/internal/game"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden images in testdata")
+
+// goldenTolerance is the largest difference allowed in any color channel
+// of a pixel, so rounding on another GPU doesn't fail the comparison.
+const goldenTolerance = 8
+
+// testLoop runs the tests from inside ebiten's game loop: pixels can only be
+// read back from an image once the game has started. The tests draw onto
+// off-screen images and never onto the window, which stays unfocused, so a
+// virtual display (xvfb-run) is all a headless machine needs.
+type testLoop struct {
+	m    *testing.M
+	code int
+}
+
+func (l *testLoop) Update() error {
+	l.code = l.m.Run()
+	return ebiten.Termination
+}
+
+func (*testLoop) Draw(*ebiten.Image) {}
+
+func (*testLoop) Layout(int, int) (int, int) { return 320, 240 }
+
+func TestMain(m *testing.M) {
+	log.SetOutput(io.Discard) // Asset loading warns about optional sprites
+	loop := &testLoop{m: m, code: 1}
+	ebiten.SetWindowSize(1, 1)
+	if err := ebiten.RunGameWithOptions(loop, &ebiten.RunGameOptions{InitUnfocused: true, SkipTaskbar: true}); err != nil {
+		fmt.Fprintf(os.Stderr, "render tests need a display, such as xvfb-run on a headless machine: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(loop.code)
+}
+
+func TestDrawGameGolden(t *testing.T) {
+	images := assets.NewManagerFromFS(os.DirFS("../assets/images"))
+	for _, tc := range []struct {
+		name  string
+		snake game.Snake
+	}{
+		{
+			name: "straight",
+			snake: game.Snake{
+				Body:          []game.Position{{X: 5, Y: 4}, {X: 4, Y: 4}, {X: 3, Y: 4}, {X: 2, Y: 4}},
+				PrevBody:      []game.Position{{X: 4, Y: 4}, {X: 3, Y: 4}, {X: 2, Y: 4}, {X: 1, Y: 4}},
+				Direction:     game.DirRight,
+				PrevDirection: game.DirRight,
+				NextDir:       game.DirRight,
+				SpeedFactor:   1,
+				MoveProgress:  0.5,
+				IsPlayer:      true,
+			},
+		},
+		{
+			// Halfway through the first step up after heading right
+			name: "turning",
+			snake: game.Snake{
+				Body:          []game.Position{{X: 5, Y: 3}, {X: 5, Y: 4}, {X: 4, Y: 4}, {X: 3, Y: 4}},
+				PrevBody:      []game.Position{{X: 5, Y: 4}, {X: 4, Y: 4}, {X: 3, Y: 4}, {X: 2, Y: 4}},
+				Direction:     game.DirUp,
+				PrevDirection: game.DirRight,
+				NextDir:       game.DirUp,
+				SpeedFactor:   1,
+				MoveProgress:  0.5,
+				IsPlayer:      true,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state := boardState(10, 8, &tc.snake)
+			screen := ebiten.NewImage(state.GridWidth*View.CellSize, state.GridHeight*View.CellSize)
+			DrawGame(screen, state, images)
+			checkGolden(t, screen, filepath.Join("testdata", tc.name+".png"))
+		})
+	}
+}
+
+// boardState returns the state of an open width x height board with player
+// at the start of a round.
+func boardState(width, height int, player *game.Snake) game.RenderableState {
+	board := game.Bounds{MaxX: width - 1, MaxY: height - 1}
+	return game.RenderableState{
+		Players:           []*game.Snake{player},
+		Scores:            []int{0},
+		PlayerLength:      len(player.Body),
+		SafeZone:          board,
+		NextSafeZone:      board,
+		GridWidth:         width,
+		GridHeight:        height,
+		PlayerSpeedFactor: 1,
+	}
+}
+
+// checkGolden compares img with the golden image at path, pixel by pixel
+// within goldenTolerance. With -update it writes img there instead.
+func checkGolden(t *testing.T, img image.Image, path string) {
+	t.Helper()
+	if *update {
+		if err := writePNG(path, img); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		t.Fatalf("no golden image %s (run the tests with -update to create it)", path)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	want, err := png.Decode(f)
+	if err != nil {
+		t.Fatalf("decoding %s: %v", path, err)
+	}
+
+	if img.Bounds().Size() != want.Bounds().Size() {
+		t.Fatalf("image is %v, golden %s is %v", img.Bounds().Size(), path, want.Bounds().Size())
+	}
+	bad := 0
+	var first image.Point
+	for y := 0; y < want.Bounds().Dy(); y++ {
+		for x := 0; x < want.Bounds().Dx(); x++ {
+			gr, gg, gb, ga := img.At(img.Bounds().Min.X+x, img.Bounds().Min.Y+y).RGBA()
+			wr, wg, wb, wa := want.At(want.Bounds().Min.X+x, want.Bounds().Min.Y+y).RGBA()
+			if channelDiff(gr, wr) > goldenTolerance || channelDiff(gg, wg) > goldenTolerance ||
+				channelDiff(gb, wb) > goldenTolerance || channelDiff(ga, wa) > goldenTolerance {
+				if bad == 0 {
+					first = image.Pt(x, y)
+				}
+				bad++
+			}
+		}
+	}
+	if bad > 0 {
+		t.Errorf("%d pixels differ from %s, the first at %v", bad, path, first)
+	}
+}
+
+// channelDiff returns the difference between two 16-bit color channels in
+// 8-bit steps.
+func channelDiff(a, b uint32) uint32 {
+	if a > b {
+		return (a - b) >> 8
+	}
+	return (b - a) >> 8
+}
+
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,63 @@
+package render
+
+import (
+	"math"
+	"math/rand"
+)
+
+// ShakeDuration is how long a camera shake takes to die down (seconds).
+const ShakeDuration = 0.3
+
+// ShakeIntensity scales every camera shake; 0 turns shaking off (e.g. to
+// reduce motion), 1 is the default strength.
+var ShakeIntensity = 1.0
+
+// Shake is a decaying camera shake. Trigger starts one, Update advances it
+// once per tick and Offset gives the displacement to draw the board at.
+// Once the shake runs out the offset is exactly zero, so the board never
+// drifts.
+type Shake struct {
+	amplitude float64 // Peak displacement (px) of the running shake
+	left      float64 // Seconds until it settles
+	offX      float64
+	offY      float64
+}
+
+// Trigger starts a shake of the given peak displacement in pixels. A shake
+// that is already running is only replaced if the new one is stronger.
+func (s *Shake) Trigger(intensity float64) {
+	intensity *= ShakeIntensity
+	if intensity <= 0 || intensity < s.current() {
+		return
+	}
+	s.amplitude = intensity
+	s.left = ShakeDuration
+}
+
+// Update advances the shake by deltaTime seconds and picks this tick's offset.
+func (s *Shake) Update(deltaTime float64) {
+	if s.left <= 0 {
+		return
+	}
+	s.left -= deltaTime
+	if s.left <= 0 {
+		s.left, s.offX, s.offY = 0, 0, 0
+		return
+	}
+	angle := rand.Float64() * 2 * math.Pi
+	s.offX = math.Round(math.Cos(angle) * s.current())
+	s.offY = math.Round(math.Sin(angle) * s.current())
+}
+
+// Offset returns the current displacement in whole pixels.
+func (s *Shake) Offset() (float64, float64) {
+	return s.offX, s.offY
+}
+
+// current is the amplitude after linear decay.
+func (s *Shake) current() float64 {
+	if s.left <= 0 {
+		return 0
+	}
+	return s.amplitude * s.left / ShakeDuration
+}
@@ -0,0 +1,157 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/colorm"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"snake-game/internal/game"
+)
+
+// Fallback shapes: without the head or body sprite a snake is drawn as a
+// chain of rounded squares, with a rounder head and a tapering tail. With
+// RoundedShapes off they are plain squares, as are the food discs.
+const (
+	shapeSegmentSize = 0.84 // Size of a body segment (fraction of a cell)
+	shapeHeadSize    = 0.96 // Size of the head
+	shapeTailSize    = 0.6  // Size of the last segment
+	shapeCornerRound = 0.35 // Corner radius of the segments (fraction of their size)
+	shapeEyeOffset   = 0.2  // Distance (cells) of the eyes from the middle of the head, forwards and sideways
+	shapeEyeSize     = 0.09 // Radius (cells) of each eye
+)
+
+// RoundedShapes draws the shapes standing in for missing sprites (snakes
+// and food) smoothed and antialiased, rather than as hard-edged squares.
+var RoundedShapes = true
+
+var (
+	shapeSnakeColor = color.RGBA{R: 40, G: 200, B: 70, A: 255} // Green like the sprites, recolored the same way
+	shapeEyeColor   = color.RGBA{R: 10, G: 20, B: 10, A: 255}
+)
+
+// whitePixel is the source image vector paths are filled from.
+var whitePixel = func() *ebiten.Image {
+	img := ebiten.NewImage(3, 3)
+	img.Fill(color.White)
+	return img.SubImage(image.Rect(1, 1, 2, 2)).(*ebiten.Image)
+}()
+
+// drawShapeSnake draws s without sprites, in the colors drawSnake would
+// give its sprites: cm recolors shapeSnakeColor and effects goes on top. The
+// tail is drawn first so the head ends up on top.
+func drawShapeSnake(screen *ebiten.Image, s game.Snake, cm, effects colorm.ColorM) {
+	head := cm
+	if s.ShieldCount > 0 {
+		head.Translate(0, 0.25, 0.35, 0) // Turquoise glow while shielded, as on the sprite
+	}
+	cm.Concat(effects)
+	head.Concat(effects)
+	body := cm.Apply(shapeSnakeColor)
+	for i := len(s.Body) - 1; i >= 0; i-- {
+		x, y := segmentPosition(s, i)
+		size := shapeSegmentSize
+		switch {
+		case i == 0:
+			size = shapeHeadSize
+		case i == len(s.Body)-1:
+			size = shapeTailSize
+		}
+		clr := body
+		if i == 0 {
+			clr = head.Apply(shapeSnakeColor)
+		}
+		drawCellShape(screen, x, y, size, clr)
+	}
+
+	// Eyes on the head, looking the way it is turning to
+	cell := float64(View.CellSize)
+	x, y := segmentPosition(s, 0)
+	angle := turnAngle(s.PrevDirection, s.Direction, s.MoveProgress)
+	cx, cy := View.CellCenter(x+shapeEyeOffset*math.Cos(angle), y+shapeEyeOffset*math.Sin(angle))
+	sx, sy := -shapeEyeOffset*math.Sin(angle)*cell, shapeEyeOffset*math.Cos(angle)*cell
+	_, _, _, a := body.RGBA()
+	eye := fade(shapeEyeColor, float64(a)/0xffff) // As see-through as the body
+	for _, side := range []float64{-1, 1} {
+		drawDisc(screen, float32(cx+side*sx), float32(cy+side*sy), float32(shapeEyeSize*cell), eye)
+	}
+}
+
+// fade returns clr with its opacity scaled by alpha (0..1).
+func fade(clr color.RGBA, alpha float64) color.RGBA {
+	scale := func(v uint8) uint8 { return uint8(float64(v) * alpha) } // Premultiplied, so every channel scales
+	return color.RGBA{R: scale(clr.R), G: scale(clr.G), B: scale(clr.B), A: scale(clr.A)}
+}
+
+// segmentPosition returns where segment i of s is drawn (in cells, from the
+// top-left corner of its cell), sliding between its previous and current
+// cells as drawSnake does.
+func segmentPosition(s game.Snake, i int) (float64, float64) {
+	from, to := s.PrevBody[i], s.Body[i]
+	if teleported(from, to) {
+		return float64(to.X), float64(to.Y) // Don't slide across the board
+	}
+	t := s.MoveProgress
+	return float64(from.X) + float64(to.X-from.X)*t, float64(from.Y) + float64(to.Y-from.Y)*t
+}
+
+// drawCellShape draws a square of size (fraction of a cell) centered in the
+// cell at x, y (in cells), rounded when RoundedShapes is on.
+func drawCellShape(screen *ebiten.Image, x, y, size float64, clr color.Color) {
+	side := float32(size * float64(View.CellSize))
+	cx, cy := View.CellCenter(x, y)
+	px := float32(cx) - side/2
+	py := float32(cy) - side/2
+	if !RoundedShapes {
+		vector.DrawFilledRect(screen, px, py, side, side, clr, false)
+		return
+	}
+	drawRoundedRect(screen, px, py, side, side, side*shapeCornerRound, clr)
+}
+
+// drawDisc draws a circle, or a square of the same width with
+// RoundedShapes off.
+func drawDisc(screen *ebiten.Image, cx, cy, radius float32, clr color.Color) {
+	if !RoundedShapes {
+		vector.DrawFilledRect(screen, cx-radius, cy-radius, 2*radius, 2*radius, clr, false)
+		return
+	}
+	vector.DrawFilledCircle(screen, cx, cy, radius, clr, true)
+}
+
+// drawRoundedRect fills an antialiased rectangle whose corners are rounded
+// with radius r.
+func drawRoundedRect(screen *ebiten.Image, x, y, w, h, r float32, clr color.Color) {
+	var path vector.Path
+	path.MoveTo(x+r, y)
+	path.LineTo(x+w-r, y)
+	path.ArcTo(x+w, y, x+w, y+r, r)
+	path.LineTo(x+w, y+h-r)
+	path.ArcTo(x+w, y+h, x+w-r, y+h, r)
+	path.LineTo(x+r, y+h)
+	path.ArcTo(x, y+h, x, y+h-r, r)
+	path.LineTo(x, y+r)
+	path.ArcTo(x, y, x+r, y, r)
+	path.Close()
+	fillPath(screen, &path, clr)
+}
+
+// fillPath fills a closed vector path with clr, antialiased.
+func fillPath(screen *ebiten.Image, path *vector.Path, clr color.Color) {
+	vs, is := path.AppendVerticesAndIndicesForFilling(nil, nil)
+	cr, cg, cb, ca := clr.RGBA() // Premultiplied
+	for i := range vs {
+		vs[i].SrcX, vs[i].SrcY = 1, 1
+		vs[i].ColorR = float32(cr) / 0xffff
+		vs[i].ColorG = float32(cg) / 0xffff
+		vs[i].ColorB = float32(cb) / 0xffff
+		vs[i].ColorA = float32(ca) / 0xffff
+	}
+	op := &ebiten.DrawTrianglesOptions{}
+	op.ColorScaleMode = ebiten.ColorScaleModePremultipliedAlpha
+	op.AntiAlias = true
+	screen.DrawTriangles(vs, is, whitePixel, op)
+}
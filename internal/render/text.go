@@ -0,0 +1,84 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// Font sizes in pixels.
+const (
+	TitleFontSize = 32 // Scene titles ("GAME OVER", "PAUSED", ...)
+	BodyFontSize  = 16 // Menus, prompts and the HUD
+)
+
+// TextColor is the default color for UI text.
+var TextColor color.Color = color.White
+
+// Font faces parsed once from the embedded Go fonts. The mono face keeps
+// columns (e.g. the high score table) aligned.
+var (
+	regularSource = mustLoadFont(goregular.TTF)
+	monoSource    = mustLoadFont(gomono.TTF)
+)
+
+// mustLoadFont parses a TTF; the fonts are embedded, so failure is a build problem.
+func mustLoadFont(ttf []byte) *text.GoTextFaceSource {
+	src, err := text.NewGoTextFaceSource(bytes.NewReader(ttf))
+	if err != nil {
+		panic(fmt.Sprintf("render: parsing embedded font: %v", err))
+	}
+	return src
+}
+
+// face returns the regular or mono face at the given size.
+func face(size float64, mono bool) text.Face {
+	if mono {
+		return &text.GoTextFace{Source: monoSource, Size: size}
+	}
+	return &text.GoTextFace{Source: regularSource, Size: size}
+}
+
+// MeasureText returns the width and height of str in pixels at the given size.
+func MeasureText(str string, size float64) (float64, float64) {
+	return measure(str, face(size, false))
+}
+
+// measure returns the size of str in face f, using the face's own line height.
+func measure(str string, f text.Face) (float64, float64) {
+	m := f.Metrics()
+	return text.Measure(str, f, m.HAscent+m.HDescent+m.HLineGap)
+}
+
+// DrawText draws str with its top-left corner at (x, y).
+func DrawText(screen *ebiten.Image, str string, x, y int, size float64, clr color.Color) {
+	drawText(screen, str, float64(x), float64(y), face(size, false), clr)
+}
+
+// DrawCentered draws str horizontally centered on x, with the top of the
+// line at y.
+func DrawCentered(screen *ebiten.Image, str string, x, y int, size float64, clr color.Color) {
+	f := face(size, false)
+	w, _ := measure(str, f)
+	drawText(screen, str, float64(x)-w/2, float64(y), f, clr)
+}
+
+// DrawCenteredMono is DrawCentered using the monospaced face, for tables.
+func DrawCenteredMono(screen *ebiten.Image, str string, x, y int, size float64, clr color.Color) {
+	f := face(size, true)
+	w, _ := measure(str, f)
+	drawText(screen, str, float64(x)-w/2, float64(y), f, clr)
+}
+
+// drawText draws str in face f with its top-left corner at (x, y).
+func drawText(screen *ebiten.Image, str string, x, y float64, f text.Face, clr color.Color) {
+	op := &text.DrawOptions{}
+	op.GeoM.Translate(x, y)
+	op.ColorScale.ScaleWithColor(clr)
+	text.Draw(screen, str, f, op)
+}
@@ -0,0 +1,127 @@
+package render
+
+import (
+	"image/color"
+
+	"snake-game/internal/game"
+)
+
+// Theme is a color palette the board is drawn with. Effect colors (tints,
+// flashes, the HUD bars) are shared by every theme.
+type Theme struct {
+	Name       string
+	Sprites    bool                         // Draw the background and wall sprites when loaded, rather than the plain colors
+	Background color.RGBA                   // Fills the screen without a background sprite
+	Grid       color.RGBA                   // Grid lines
+	Wall       color.RGBA                   // Board edges, obstacles without a sprite and closed survival cells
+	PlayerTint color.RGBA                   // Recolors player 1's sprites; zero keeps them as drawn
+	Players    [game.MaxPlayers]color.RGBA  // Each player's HUD and minimap color, matching their (hue-shifted) sprites
+	Enemy      color.RGBA                   // Enemies on the minimap
+	Food       map[game.FoodType]color.RGBA // Signature color of each food type; standard food is the fallback
+}
+
+// Themes are the palettes selectable from the options. The first is the
+// game's original look.
+var Themes = []Theme{
+	{
+		Name:       "Classic Green",
+		Sprites:    true,
+		Background: color.RGBA{R: 15, G: 15, B: 25, A: 255},    // Dark blue-ish background
+		Grid:       color.RGBA{R: 50, G: 50, B: 70, A: 255},    // Faint grid lines
+		Wall:       color.RGBA{R: 100, G: 100, B: 120, A: 255}, // Color for boundaries
+		Players: [game.MaxPlayers]color.RGBA{
+			{R: 0, G: 255, B: 80, A: 255},
+			{R: 90, G: 160, B: 255, A: 255},
+			{R: 60, G: 230, B: 230, A: 255},
+			{R: 255, G: 90, B: 90, A: 255},
+		},
+		Enemy: color.RGBA{R: 255, G: 50, B: 50, A: 255},
+		Food: map[game.FoodType]color.RGBA{
+			game.FoodTypeStandard: {R: 255, G: 0, B: 0, A: 255},     // Red
+			game.FoodTypeSpeedUp:  {R: 255, G: 165, B: 0, A: 255},   // Orange
+			game.FoodTypeSlowDown: {R: 0, G: 191, B: 255, A: 255},   // Deep Sky Blue
+			game.FoodTypeTeleport: {R: 180, G: 80, B: 255, A: 255},  // Purple portal
+			game.FoodTypeShrink:   {R: 255, G: 105, B: 180, A: 255}, // Hot pink
+			game.FoodTypeShield:   {R: 64, G: 224, B: 208, A: 255},  // Turquoise, also used for the shield HUD
+			game.FoodTypeBomb:     {R: 220, G: 30, B: 30, A: 255},   // Red rim of the bomb
+			game.FoodTypeGolden:   {R: 255, G: 200, B: 30, A: 255},  // Gold, also the golden apple's glow
+			game.FoodTypeGhost:    {R: 225, G: 225, B: 255, A: 255}, // Pale lavender, also used for the ghost HUD
+		},
+	},
+	{
+		Name:       "Dark",
+		Background: color.RGBA{R: 6, G: 6, B: 8, A: 255},
+		Grid:       color.RGBA{R: 28, G: 28, B: 34, A: 255},
+		Wall:       color.RGBA{R: 64, G: 64, B: 72, A: 255},
+		Players: [game.MaxPlayers]color.RGBA{
+			{R: 0, G: 190, B: 70, A: 255},
+			{R: 70, G: 130, B: 220, A: 255},
+			{R: 40, G: 180, B: 180, A: 255},
+			{R: 200, G: 70, B: 70, A: 255},
+		},
+		Enemy: color.RGBA{R: 190, G: 40, B: 40, A: 255},
+		Food: map[game.FoodType]color.RGBA{
+			game.FoodTypeStandard: {R: 200, G: 30, B: 30, A: 255},
+			game.FoodTypeSpeedUp:  {R: 210, G: 130, B: 20, A: 255},
+			game.FoodTypeSlowDown: {R: 30, G: 150, B: 210, A: 255},
+			game.FoodTypeTeleport: {R: 140, G: 70, B: 210, A: 255},
+			game.FoodTypeShrink:   {R: 210, G: 90, B: 150, A: 255},
+			game.FoodTypeShield:   {R: 50, G: 180, B: 170, A: 255},
+			game.FoodTypeBomb:     {R: 180, G: 25, B: 25, A: 255},
+			game.FoodTypeGolden:   {R: 220, G: 170, B: 30, A: 255},
+			game.FoodTypeGhost:    {R: 180, G: 180, B: 210, A: 255},
+		},
+	},
+	{
+		Name:       "Neon",
+		Background: color.RGBA{R: 10, G: 0, B: 20, A: 255},
+		Grid:       color.RGBA{R: 60, G: 0, B: 90, A: 255},
+		Wall:       color.RGBA{R: 0, G: 240, B: 255, A: 255},
+		PlayerTint: color.RGBA{R: 255, G: 40, B: 255, A: 255}, // Magenta snake
+		Players: [game.MaxPlayers]color.RGBA{
+			{R: 255, G: 40, B: 255, A: 255},
+			{R: 0, G: 200, B: 255, A: 255},
+			{R: 0, G: 255, B: 200, A: 255},
+			{R: 255, G: 80, B: 60, A: 255},
+		},
+		Enemy: color.RGBA{R: 255, G: 255, B: 0, A: 255},
+		Food: map[game.FoodType]color.RGBA{
+			game.FoodTypeStandard: {R: 255, G: 20, B: 80, A: 255},
+			game.FoodTypeSpeedUp:  {R: 255, G: 140, B: 0, A: 255},
+			game.FoodTypeSlowDown: {R: 0, G: 220, B: 255, A: 255},
+			game.FoodTypeTeleport: {R: 190, G: 0, B: 255, A: 255},
+			game.FoodTypeShrink:   {R: 255, G: 60, B: 200, A: 255},
+			game.FoodTypeShield:   {R: 0, G: 255, B: 180, A: 255},
+			game.FoodTypeBomb:     {R: 255, G: 0, B: 0, A: 255},
+			game.FoodTypeGolden:   {R: 255, G: 230, B: 0, A: 255},
+			game.FoodTypeGhost:    {R: 240, G: 240, B: 255, A: 255},
+		},
+	},
+}
+
+// ActiveTheme is the index into Themes the game is drawn with (set from the
+// options).
+var ActiveTheme = 0
+
+// CurrentTheme returns the active theme, or the first one if ActiveTheme is
+// out of range.
+func CurrentTheme() Theme {
+	if ActiveTheme < 0 || ActiveTheme >= len(Themes) {
+		return Themes[0]
+	}
+	return Themes[ActiveTheme]
+}
+
+// PlayerColor returns the color of the i-th player (0 is player 1) in the
+// active theme, e.g. for effects.
+func PlayerColor(i int) color.Color {
+	return CurrentTheme().Players[i%game.MaxPlayers]
+}
+
+// FoodColor returns the theme's signature color for a food type.
+func (t Theme) FoodColor(ft game.FoodType) color.RGBA {
+	if clr, ok := t.Food[ft]; ok {
+		return clr
+	}
+	return t.Food[game.FoodTypeStandard]
+}
@@ -0,0 +1,63 @@
+// Package savegame keeps the one saved, in-progress round in the user config
+// directory, so it can be continued from the main menu.
+package savegame
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"snake-game/internal/game"
+	"snake-game/internal/storage"
+)
+
+const fileName = "savegame.json" // File in the user config directory
+
+// Save writes g's round in progress, replacing any earlier save.
+func Save(g *game.Game) error {
+	data, err := g.Serialize()
+	if err != nil {
+		return fmt.Errorf("saving game: %w", err)
+	}
+	path, err := storage.Path(fileName)
+	if err != nil {
+		return err
+	}
+	return storage.WriteFileAtomic(path, data)
+}
+
+// Exists reports whether a saved round is waiting to be continued.
+func Exists() bool {
+	path, err := storage.Path(fileName)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// Load returns the saved round, paused (see game.LoadGame).
+func Load() (*game.Game, error) {
+	path, err := storage.Path(fileName)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return game.LoadGame(data)
+}
+
+// Remove deletes the saved round. A missing save is not an error.
+func Remove() error {
+	path, err := storage.Path(fileName)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("removing %s: %w", path, err)
+	}
+	return nil
+}
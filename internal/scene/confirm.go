@@ -0,0 +1,73 @@
+package scene
+
+import (
+	"image/color"
+	"log"
+
+	"snake-game/internal/input"
+	"snake-game/internal/render"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Size (px) of the quit confirmation box.
+const (
+	quitPromptWidth  = 220
+	quitPromptHeight = 80
+)
+
+var (
+	quitPromptShade  = color.RGBA{R: 0, G: 0, B: 0, A: 140}    // Dims the scene under the box
+	quitPromptFill   = color.RGBA{R: 20, G: 20, B: 30, A: 235} // The box itself
+	quitPromptBorder = color.RGBA{R: 200, G: 200, B: 210, A: 255}
+)
+
+// quitPrompt is the "Quit? Y/N" dialog shown over the current scene while
+// the player confirms leaving the game (see ConfirmQuit).
+type quitPrompt struct {
+	onQuit func() // Run before quitting; may be nil
+}
+
+// ConfirmQuit asks the player whether to quit the game, in a dialog drawn
+// over the current scene. Until it is answered the scene isn't updated, so
+// it gets no input; Y or confirm runs onQuit (if not nil) and makes RunGame
+// return, N or back closes the dialog and the scene carries on.
+func (m *Manager) ConfirmQuit(onQuit func()) {
+	if m.quitPrompt != nil {
+		return
+	}
+	m.quitPrompt = &quitPrompt{onQuit: onQuit}
+}
+
+// updateQuitPrompt reads the answer to the open quit dialog. It returns
+// ebiten.Termination once quitting is confirmed.
+func (m *Manager) updateQuitPrompt() error {
+	_, action := m.inputManager.Update()
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyY) || action == input.ActionConfirm:
+		log.Println("Quit confirmed.")
+		if m.quitPrompt.onQuit != nil {
+			m.quitPrompt.onQuit()
+		}
+		m.quitPrompt = nil
+		return ebiten.Termination // Makes RunGame return cleanly
+	case inpututil.IsKeyJustPressed(ebiten.KeyN) || action == input.ActionBack || action == input.ActionPause:
+		m.quitPrompt = nil
+		m.audioManager.PlayMenuMove()
+	}
+	return nil
+}
+
+// drawQuitPrompt draws the quit dialog in the middle of a width x height
+// screen, dimming the scene around it.
+func drawQuitPrompt(screen *ebiten.Image, width, height int) {
+	vector.DrawFilledRect(screen, 0, 0, float32(width), float32(height), quitPromptShade, false)
+	x := float32(width-quitPromptWidth) / 2
+	y := float32(height-quitPromptHeight) / 2
+	vector.DrawFilledRect(screen, x, y, quitPromptWidth, quitPromptHeight, quitPromptFill, false)
+	vector.StrokeRect(screen, x, y, quitPromptWidth, quitPromptHeight, 2, quitPromptBorder, false)
+	render.DrawCentered(screen, "Quit? Y/N", width/2, height/2-14, render.TitleFontSize, render.TextColor)
+	render.DrawCentered(screen, "Enter to quit, Esc to stay", width/2, height/2+22, render.BodyFontSize, render.TextColor)
+}
@@ -4,20 +4,44 @@ import (
 	"fmt"
 	"image/color"
 	"log"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"snake-game/internal/game"
 	"snake-game/internal/input"
+	"snake-game/internal/render"
 	"snake-game/internal/scene"
+	"snake-game/internal/score"
+	"snake-game/internal/stats"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
+const cursorBlinkRate = 2.0 // Blinks per second of the name entry cursor
+
 // GameOverScene displays the game over message and score.
 type GameOverScene struct {
-	sceneMgr   scene.ManagerInterface
-	inputMgr   *input.Manager
-	finalScore int
+	sceneMgr    scene.ManagerInterface
+	inputMgr    *input.Manager
+	finalScore  int
+	lengthBonus int           // Part of finalScore earned by the snake's length
+	multiplayer bool          // The round was a multiplayer match; no high scores are kept
+	scores      []int         // Every player's final score in a multiplayer match
+	winner      int           // Winning player (1 to game.MaxPlayers), 0 for a draw
+	timeUp      bool          // The round was a time attack, ended by the clock
+	highScores  []score.Entry // Table including this run (if it qualified)
+	rank        int           // This run's position in highScores, -1 if not listed
+	entering    bool          // Waiting for the player to type a name for this run's entry
+	name        []rune        // Name typed so far
+	chars       []rune        // Reused buffer for ebiten.AppendInputChars
+	ticks       int           // Updates since the scene loaded, for the cursor blink
+	allTime     stats.Stats   // Lifetime stats, this round included
+	haveAllTime bool          // allTime could be loaded
 	// Add assets like fonts if needed
 }
 
@@ -32,10 +56,60 @@ func (s *GameOverScene) Load(manager scene.ManagerInterface, gameData *game.Game
 	log.Println("Loading GameOver Scene")
 	s.sceneMgr = manager
 	s.inputMgr = manager.GetInputManager()
-	s.finalScore = gameData.Score // Get score from the ended game state
+	s.finalScore = 0
+	if len(gameData.Scores) > 0 {
+		s.finalScore = gameData.Scores[0] // Player 1's score from the ended game state
+	}
+	s.lengthBonus = gameData.LengthBonus
+	s.multiplayer = len(gameData.Players) > 1
+	s.scores = slices.Clone(gameData.Scores)
+	s.winner = gameData.Winner
+	s.timeUp = gameData.TimeAttack()
+	s.highScores, s.rank = nil, -1
+	s.entering, s.name, s.ticks = false, nil, 0
+	if !s.multiplayer && !gameData.Replaying && !gameData.Practice() {
+		s.recordScore()
+	}
+	s.loadAllTime()
 	// Load assets if needed
 }
 
+// recordScore places this run in the high score table. A run that makes
+// the table waits for the player to type a name before it is saved (see
+// saveScore); one that doesn't is only shown against the table.
+// Failures are logged; the screen still shows whatever could be loaded.
+func (s *GameOverScene) recordScore() {
+	entry := score.Entry{Score: s.finalScore, Time: time.Now()}
+	scores, err := score.LoadScores()
+	if err != nil {
+		log.Printf("Warning: Failed to load high scores: %v", err)
+		scores = nil
+	}
+	s.highScores, s.rank = score.Insert(scores, entry)
+	s.entering = s.rank >= 0
+}
+
+// saveScore writes this run's entry, with the name typed, to the saved
+// high score table.
+func (s *GameOverScene) saveScore() {
+	s.entering = false
+	s.highScores[s.rank].Name = strings.TrimSpace(string(s.name))
+	if err := score.SaveScore(s.highScores[s.rank]); err != nil {
+		log.Printf("Warning: Failed to save high score: %v", err)
+	}
+}
+
+// loadAllTime reads the lifetime stats (the gameplay scene has already
+// added this round). Failures are logged and the section is left out.
+func (s *GameOverScene) loadAllTime() {
+	var err error
+	s.allTime, err = stats.Load()
+	s.haveAllTime = err == nil
+	if err != nil {
+		log.Printf("Warning: Failed to load stats: %v", err)
+	}
+}
+
 // Unload cleans up the scene.
 func (s *GameOverScene) Unload() scene.SceneType {
 	log.Println("Unloading GameOver Scene")
@@ -43,17 +117,23 @@ func (s *GameOverScene) Unload() scene.SceneType {
 	return scene.SceneTypeGameOver
 }
 
-// Update handles input for restarting or exiting.
+// Update handles input for restarting or exiting, or the name entry while
+// a new high score is waiting for its name.
 func (s *GameOverScene) Update(manager scene.ManagerInterface) (scene.Transition, error) {
+	s.ticks++
+	if s.entering {
+		s.updateNameEntry()
+		return scene.Transition{}, nil
+	}
+
 	_, action := s.inputMgr.Update()
 
 	switch action {
 	case input.ActionConfirm: // Typically Space or Enter
 		// Transition back to Gameplay (which will call Reset)
 		return scene.Transition{FromScene: scene.SceneTypeGameOver, ToScene: scene.SceneTypeGameplay}, nil
-	case input.ActionBack: // Typically Escape
-		// TODO: Implement transition to Main Menu or Exit
-		log.Println("Exit/Back action from GameOver not implemented yet.")
+	case input.ActionBack: // Q/Backspace
+		return scene.Transition{FromScene: scene.SceneTypeGameOver, ToScene: scene.SceneTypeMainMenu}, nil
 	}
 
 	// No transition requested
@@ -70,19 +150,115 @@ func (s *GameOverScene) Draw(screen *ebiten.Image) {
 
 	// Game Over Text
 	title := "GAME OVER"
+	if s.timeUp {
+		title = "TIME'S UP"
+	}
 	scoreMsg := fmt.Sprintf("Final Score: %d", s.finalScore)
-	prompt := "Press Space/Enter to Restart"
+	prompt := "Press Space/Enter to Restart, Q/Backspace for Menu"
 
-	// Basic text rendering (Improve with actual fonts later)
-	titleX := (width - len(title)*8) / 2
-	scoreX := (width - len(scoreMsg)*8) / 2
-	promptX := (width - len(prompt)*8) / 2
+	centerX := width / 2
+	titleY := height/2 - 170
+	scoreY := height/2 - 125
+	promptY := height/2 + 170
+	allTimeY := height/2 + 142
 
-	titleY := height/2 - 30
-	scoreY := height / 2
-	promptY := height/2 + 30
+	render.DrawCentered(screen, title, centerX, titleY, render.TitleFontSize, render.TextColor)
+	s.drawAllTime(screen, centerX, allTimeY)
+	if s.multiplayer {
+		s.drawVersusResult(screen, centerX, scoreY)
+		render.DrawCentered(screen, prompt, centerX, promptY, render.BodyFontSize, render.TextColor)
+		return
+	}
+	render.DrawCentered(screen, scoreMsg, centerX, scoreY, render.BodyFontSize, render.TextColor)
+	breakdown := fmt.Sprintf("Food: %d  +  Length Bonus: %d (%d x %d)", s.finalScore-s.lengthBonus, s.lengthBonus,
+		s.lengthBonus/game.LengthBonusPoints, game.LengthBonusPoints)
+	render.DrawCentered(screen, breakdown, centerX, scoreY+20, render.BodyFontSize, render.TextColor)
+	if s.rank == 0 {
+		record := "NEW HIGH SCORE!"
+		render.DrawCentered(screen, record, centerX, scoreY+40, render.BodyFontSize, render.TextColor)
+	}
+
+	// High score table, marking this run's entry (mono face keeps the columns aligned)
+	header := "HIGH SCORES"
+	render.DrawCentered(screen, header, centerX, height/2-62, render.BodyFontSize, render.TextColor)
+	for i, entry := range s.highScores {
+		line := fmt.Sprintf("%2d. %6d  %-*s  %s", i+1, entry.Score, score.MaxNameLength, s.entryName(i, entry),
+			entry.Time.Format("2006-01-02"))
+		if i == s.rank {
+			line = "> " + line + " <"
+		} else {
+			line = "  " + line + "  "
+		}
+		render.DrawCenteredMono(screen, line, centerX, height/2-38+i*17, render.BodyFontSize, render.TextColor)
+	}
 
-	ebitenutil.DebugPrintAt(screen, title, titleX, titleY)
-	ebitenutil.DebugPrintAt(screen, scoreMsg, scoreX, scoreY)
-	ebitenutil.DebugPrintAt(screen, prompt, promptX, promptY)
+	if s.entering {
+		prompt = fmt.Sprintf("Type your name (%d-%d characters), Enter to save, Esc to skip", score.MinNameLength, score.MaxNameLength)
+	}
+	render.DrawCentered(screen, prompt, centerX, promptY, render.BodyFontSize, render.TextColor)
+}
+
+// updateNameEntry takes the characters typed this frame into the name,
+// Backspace deleting the last one, and saves the entry on Enter once the
+// name has at least score.MinNameLength characters; Esc saves it without a
+// name. Letters, digits and spaces are accepted, up to score.MaxNameLength.
+func (s *GameOverScene) updateNameEntry() {
+	s.chars = ebiten.AppendInputChars(s.chars[:0])
+	for _, r := range s.chars {
+		if len(s.name) < score.MaxNameLength && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == ' ') {
+			s.name = append(s.name, r)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(s.name) > 0 {
+		s.name = s.name[:len(s.name)-1]
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter) {
+		if utf8.RuneCountInString(strings.TrimSpace(string(s.name))) >= score.MinNameLength {
+			s.saveScore()
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		s.name = nil
+		s.saveScore()
+	}
+}
+
+// entryName returns the name shown for high score i: the name being typed,
+// with a blinking cursor, for this run's entry during the name entry.
+func (s *GameOverScene) entryName(i int, entry score.Entry) string {
+	if !s.entering || i != s.rank {
+		return entry.Name
+	}
+	seconds := float64(s.ticks) / float64(ebiten.TPS())
+	if len(s.name) < score.MaxNameLength && int(seconds*cursorBlinkRate*2)%2 == 0 {
+		return string(s.name) + "_"
+	}
+	return string(s.name)
+}
+
+// drawAllTime sums up the lifetime stats on one line (see the stats scene
+// for all of them).
+func (s *GameOverScene) drawAllTime(screen *ebiten.Image, centerX, y int) {
+	if !s.haveAllTime {
+		return
+	}
+	t := s.allTime
+	line := fmt.Sprintf("All time: best %d, longest %d, %d food, %d kills, %s played",
+		t.HighestScore, t.LongestSnake, t.FoodEaten, t.EnemiesKilled, stats.FormatPlayTime(t.PlayTime))
+	render.DrawCentered(screen, line, centerX, y, render.BodyFontSize, render.TextColor)
+}
+
+// drawVersusResult shows who won a multiplayer match and every score.
+func (s *GameOverScene) drawVersusResult(screen *ebiten.Image, centerX, y int) {
+	result := "DRAW!"
+	if s.winner != 0 {
+		result = fmt.Sprintf("PLAYER %d WINS!", s.winner)
+	}
+	parts := make([]string, len(s.scores))
+	for i, score := range s.scores {
+		parts[i] = fmt.Sprintf("P%d: %d", i+1, score)
+	}
+	scores := strings.Join(parts, "    ")
+	render.DrawCentered(screen, result, centerX, y, render.BodyFontSize, render.TextColor)
+	render.DrawCentered(screen, scores, centerX, y+24, render.BodyFontSize, render.TextColor)
 }
@@ -1,17 +1,64 @@
 package gameplay
 
 import (
+	"fmt"
 	"image/color"
 	"log"
+	"math"
+	"slices"
+	"time"
 
+	"snake-game/internal/audio"
 	"snake-game/internal/game"
 	"snake-game/internal/input"
 	"snake-game/internal/particle"
 	"snake-game/internal/render"
 	"snake-game/internal/scene"
+	"snake-game/internal/stats"
+	"snake-game/internal/storage"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const (
+	particleGravity       = 400.0 // Downward pull (px/s²) for particles that use gravity
+	particleTextureRadius = 16    // Radius (px) of the soft dot particles are drawn with, before scaling
+	deathEffectDuration   = 0.8   // Seconds the death explosion plays before Game Over
+	deathBurstSpeed       = 120.0 // Outward speed of death particles (px/s)
+	spawnBurstLifetime    = 0.35  // Seconds spawn particles take to collapse onto the new cell
+	eatBurstBias          = 0.8   // Drift of eat particles along the eater's heading, as a fraction of their spread
+	deathShake            = 8.0   // Camera shake (px) when the round ends
+	enemyDeathShake       = 3.0   // Camera shake (px) when an enemy dies
+	bombShake             = 6.0   // Camera shake (px) when a bomb goes off
+	maxStepsPerFrame      = 8     // Logic steps allowed per frame before the backlog is dropped
+	maxFrameTime          = 0.25  // Longest frame (s) counted in full; longer hitches are clamped
+
+	// ReplayFile is where the last live round's replay is saved, in the
+	// config directory (see storage.Path).
+	ReplayFile = "replay.json"
+
+	// CountdownDuration is the 3-2-1 before a fresh round starts moving (seconds).
+	CountdownDuration = 3.0
+	countdownFontSize = 96
+)
+
+// DebugKeys enables the debug hotkeys during play: [ and ] halve and double
+// the game's time scale, \ resets it.
+var DebugKeys = false
+
+// HoldToTurn makes a held direction key keep steering the player in a
+// one-player round: the snake turns that way as soon as it can, instead of
+// only on the frame the key goes down. Off by default.
+var HoldToTurn = false
+
+// LogicTickRate is how many fixed game logic steps run per second of real
+// time, however often frames are actually drawn.
+var LogicTickRate = 120.0
+
+var (
+	enemySpawnColor = color.RGBA{R: 255, G: 80, B: 0, A: 255} // Warns where a new enemy appeared
+	playerEatColor  = color.RGBA{R: 255, G: 255, B: 180, A: 255}
 )
 
 // GameplayScene holds the state for the main gameplay.
@@ -20,132 +67,482 @@ type GameplayScene struct {
 	inputMgr    *input.Manager
 	sceneMgr    scene.ManagerInterface
 	particleSys *particle.System
-	// Add specific rendering assets or state if needed
+	dying       bool    // The player has died and the death effect is playing
+	deathTimer  float64 // Seconds left before switching to Game Over
+	countdown   float64 // Seconds left before the round starts moving (0 = running)
+	shake       render.Shake
+	lastFrame   time.Time      // When the previous Update ran
+	accumulator float64        // Real time (s) not yet consumed by logic steps
+	frame       *ebiten.Image  // Offscreen board, drawn offset while shaking
+	playback    *game.Playback // Replay being shown instead of live play, if any
+	best        *bestRun       // Best run replaying alongside live play, if any
+	flashes     []game.Flash   // Food-eaten flashes still playing
+	round       stats.Round    // Tally of the round being played, for the lifetime stats
 }
 
 // NewGameplayScene creates a new gameplay scene instance.
 func NewGameplayScene() *GameplayScene {
-	ps := particle.NewSystem(0)
+	ps := particle.NewSystem(particleGravity)
+	ps.Texture = particle.NewSoftDot(particleTextureRadius) // Glowing sparks rather than squares
 	return &GameplayScene{
 		particleSys: ps,
 	}
 }
 
+// NewReplayScene creates a gameplay scene that plays back a recorded round.
+// Steering input is ignored; pausing and restarting (which starts the replay
+// over) still work.
+func NewReplayScene(replay game.Replay) *GameplayScene {
+	s := NewGameplayScene()
+	s.playback = game.NewPlayback(replay)
+	return s
+}
+
 // Load initializes the scene.
 func (s *GameplayScene) Load(manager scene.ManagerInterface, gameData *game.Game) {
 	log.Println("Loading Gameplay Scene")
 	s.sceneMgr = manager
 	s.inputMgr = manager.GetInputManager()
 	s.gameData = gameData
-	s.gameData.Reset()
+	if s.gameData.IsPaused {
+		// Coming back from the pause scene: continue the same round
+		s.gameData.TogglePause()
+	} else {
+		s.startRound()
+	}
 	s.particleSys.Particles = s.particleSys.Particles[:0]
-	// Load gameplay-specific assets here (e.g., sounds)
+	s.lastFrame = time.Now() // Time spent in other scenes doesn't count
+	s.accumulator = 0
+	manager.GetMusic().Play(audio.TrackGameplay)
 }
 
-// Unload cleans up the scene.
+// Unload cleans up the scene. The music keeps playing (ducked) under the
+// pause menu and stops for anything else.
 func (s *GameplayScene) Unload() scene.SceneType {
 	log.Println("Unloading Gameplay Scene")
-	// Unload assets
+	if !s.gameData.IsPaused {
+		s.sceneMgr.GetMusic().Stop()
+	}
 	return scene.SceneTypeGameplay
 }
 
 // Update handles game logic updates.
 func (s *GameplayScene) Update(manager scene.ManagerInterface) (scene.Transition, error) {
-	// 1. Handle Input
-	dir, action := s.inputMgr.Update()
+	deltaTime := s.frameTime()
+	effectTime := s.gameData.ScaledTime(deltaTime) // Effects follow the game's time scale
+
+	// Let the death effect finish before leaving; input is ignored meanwhile
+	s.shake.Update(effectTime)
+
+	if s.dying {
+		s.particleSys.Update(effectTime)
+		s.deathTimer -= effectTime
+		if s.deathTimer <= 0 {
+			return scene.Transition{FromScene: scene.SceneTypeGameplay, ToScene: scene.SceneTypeGameOver}, nil
+		}
+		return scene.Transition{}, nil
+	}
+
+	// Losing focus (e.g. alt-tab) pauses the round, which then stays paused
+	// until the player resumes it from the pause menu. The countdown just
+	// holds, since nothing is moving yet.
+	if !ebiten.IsFocused() {
+		if s.countdown > 0 {
+			return scene.Transition{}, nil
+		}
+		s.gameData.TogglePause()
+		return scene.Transition{FromScene: scene.SceneTypeGameplay, ToScene: scene.SceneTypePause}, nil
+	}
 
-	if dir != game.DirNone {
-		s.gameData.HandleInput(dir)
+	// Countdown: steering is accepted (so the first move can be queued) but
+	// the game clock doesn't run and the snakes stay put
+	if s.countdown > 0 {
+		action := s.readInput()
+		if action == input.ActionRestart {
+			s.restart()
+			return scene.Transition{}, nil
+		}
+		s.countdown -= deltaTime
+		s.particleSys.Update(effectTime)
+		return scene.Transition{}, nil
 	}
 
+	// 1. Handle Input
+	action := s.readInput()
+
 	switch action {
 	case input.ActionPause:
+		// Freeze the round and hand over to the pause menu
 		s.gameData.TogglePause()
-	case input.ActionConfirm:
+		return scene.Transition{FromScene: scene.SceneTypeGameplay, ToScene: scene.SceneTypePause}, nil
 	case input.ActionRestart:
-		s.gameData.Reset()
-		s.particleSys.Particles = s.particleSys.Particles[:0]
+		s.restart()
+	}
+	if DebugKeys {
+		s.debugTimeScale()
 	}
 
 	// Update particle system
-	deltaTime := 1.0 / float64(ebiten.TPS())
-	s.particleSys.Update(deltaTime)
+	s.particleSys.Update(effectTime)
 
 	// 2. Update Game Logic (if not paused)
 	if !s.gameData.IsPaused {
-		err := s.gameData.Update(deltaTime)
+		err := s.stepGame(deltaTime)
 		if err != nil {
 			return scene.Transition{}, err
 		}
 
-		// Check if food was eaten by PLAYER
-		lastPlayerEatenPos := s.gameData.FoodEatenPos
-		if lastPlayerEatenPos != nil {
-			flashColor := color.RGBA{R: 255, G: 255, B: 180, A: 255}
-			centerX := float64(lastPlayerEatenPos.X*render.GridCellSize) + float64(render.GridCellSize)/2.0
-			centerY := float64(lastPlayerEatenPos.Y*render.GridCellSize) + float64(render.GridCellSize)/2.0
-			s.particleSys.Emit(particle.EmitConfig{
-				X:              centerX,
-				Y:              centerY,
-				Count:          15,
-				UseGravity:     false,
-				Color:          flashColor,
-				VelocitySpread: 80,
-				MinLifetime:    0.2,
-				MaxLifetime:    0.5,
-				MinSize:        1,
-				MaxSize:        3,
-			})
-			// s.gameData.FoodEatenPos = nil // Game logic now clears this based on time
-		}
-
-		// Check if food was eaten by ENEMY
-		lastEnemyEatenPos := s.gameData.EnemyFoodEatenPos
-		if lastEnemyEatenPos != nil {
-			flashColor := color.RGBA{R: 255, G: 180, B: 180, A: 255} // Different color for enemy eat
-			centerX := float64(lastEnemyEatenPos.X*render.GridCellSize) + float64(render.GridCellSize)/2.0
-			centerY := float64(lastEnemyEatenPos.Y*render.GridCellSize) + float64(render.GridCellSize)/2.0
-			s.particleSys.Emit(particle.EmitConfig{
-				X:              centerX,
-				Y:              centerY,
-				Count:          10, // Fewer particles for enemy?
-				UseGravity:     false,
-				Color:          flashColor,
-				VelocitySpread: 60,
-				MinLifetime:    0.15,
-				MaxLifetime:    0.4,
-				MinSize:        1,
-				MaxSize:        2,
-			})
-			s.gameData.EnemyFoodEatenPos = nil // Consume the event signal here
-		}
-	}
-
-	// 3. Check for Game Over state change
+		// React to what happened during the steps (particles, sounds, shake)
+		for _, ev := range s.gameData.DrainEvents() {
+			s.handleEvent(ev)
+		}
+		s.flashes = slices.DeleteFunc(s.flashes, func(f game.Flash) bool {
+			return s.gameData.GameTime-f.Time >= render.FlashDuration // Played out
+		})
+	}
+
+	// 3. Check for Game Over state change: let the death effect play, then switch scenes
 	if s.gameData.IsOver {
-		return scene.Transition{FromScene: scene.SceneTypeGameplay, ToScene: scene.SceneTypeGameOver}, nil
+		s.dying = true
+		s.deathTimer = deathEffectDuration
+		s.saveReplay()
+		s.recordStats()
 	}
 
 	// No transition requested
 	return scene.Transition{}, nil
 }
 
+// handleEvent plays the effects for one game event and counts it towards
+// the round's stats.
+func (s *GameplayScene) handleEvent(ev game.GameEvent) {
+	s.round.Add(ev)
+	switch ev.Type {
+	case game.EventFoodEaten:
+		// Splash forwards, the way the eater was heading
+		heading := ev.Snake.Direction
+		if ev.Snake.IsPlayer {
+			s.sceneMgr.GetAudio().PlayEat()
+			s.emitEatBurst(ev.Pos, playerEatColor, 15, 80, 0.5, 3, heading, eatBurstBias)
+			s.flashes = append(s.flashes, game.Flash{Pos: ev.Pos, Time: ev.Time, Color: playerEatColor})
+		} else {
+			s.emitEatBurst(ev.Pos, ev.Snake.Color, 10, 60, 0.4, 2, heading, eatBurstBias)
+			s.flashes = append(s.flashes, game.Flash{Pos: ev.Pos, Time: ev.Time, Color: ev.Snake.Color})
+		}
+		if ev.Food.Type == game.FoodTypeGolden {
+			s.emitEatBurst(ev.Pos, render.FoodColor(game.FoodTypeGolden), 40, 140, 0.8, 4, heading, eatBurstBias/2)
+		}
+	case game.EventFoodSpawned, game.EventEnemySpawned:
+		s.emitSpawnBurst(ev)
+	case game.EventEnemyDied:
+		s.emitEatBurst(ev.Pos, ev.Snake.Color, 20, 120, 0.6, 3, game.DirNone, 0)
+		s.shake.Trigger(enemyDeathShake)
+	case game.EventBombExploded:
+		s.emitEatBurst(ev.Pos, render.FoodColor(game.FoodTypeBomb), 30, 160, 0.6, 4, game.DirNone, 0)
+		s.shake.Trigger(bombShake)
+	case game.EventPlayerDied:
+		player := max(slices.Index(s.gameData.Players, ev.Snake), 0)
+		s.emitDeathBurst(ev.Body, render.PlayerColor(player)) // Matches the player's body
+	case game.EventGameOver:
+		s.sceneMgr.GetAudio().PlayDeath()
+		s.shake.Trigger(deathShake)
+	}
+}
+
+// emitEatBurst sprays a small flash of particles where food was eaten. The
+// particles drift along heading at bias times their spread on top of the
+// radial burst; DirNone (or a zero bias) keeps the burst round.
+func (s *GameplayScene) emitEatBurst(pos game.Position, clr color.Color, count int, spread, maxLife float64, maxSize float32, heading game.Direction, bias float64) {
+	x, y := render.View.CellCenter(float64(pos.X), float64(pos.Y))
+	dx, dy := heading.Delta()
+	s.particleSys.Emit(particle.EmitConfig{
+		X:              x,
+		Y:              y,
+		Count:          count,
+		Color:          clr,
+		BaseVelocityX:  float64(dx) * spread * bias,
+		BaseVelocityY:  float64(dy) * spread * bias,
+		VelocitySpread: spread,
+		MinLifetime:    maxLife * 0.4,
+		MaxLifetime:    maxLife,
+		MinSize:        1,
+		MaxSize:        maxSize,
+	})
+}
+
+// frameTime returns the real time since the previous Update, clamped so a
+// long stall (e.g. dragging the window) doesn't arrive as one huge step.
+func (s *GameplayScene) frameTime() float64 {
+	now := time.Now()
+	elapsed := now.Sub(s.lastFrame).Seconds()
+	s.lastFrame = now
+	return min(max(elapsed, 0), maxFrameTime)
+}
+
+// stepGame advances the game logic by frameTime in fixed 1/LogicTickRate
+// steps, carrying the remainder over to the next frame so snake movement is
+// the same however frames are sliced. Rendering interpolates with the
+// snakes' MoveProgress as left by the last step. At most maxStepsPerFrame
+// steps run per frame; any backlog beyond that is dropped so a slow machine
+// can't fall further and further behind.
+func (s *GameplayScene) stepGame(frameTime float64) error {
+	step := 1.0 / LogicTickRate
+	s.accumulator += frameTime
+	for steps := 0; s.accumulator >= step; steps++ {
+		if steps == maxStepsPerFrame {
+			s.accumulator = 0
+			break
+		}
+		if s.playback != nil {
+			s.playback.Feed(s.gameData)
+		}
+		if err := s.gameData.Update(step); err != nil {
+			return err
+		}
+		if s.best != nil {
+			if err := s.best.step(s.gameData.ScaledTime(step)); err != nil {
+				return err
+			}
+		}
+		s.accumulator -= step
+		if s.gameData.IsOver {
+			s.accumulator = 0
+			break
+		}
+	}
+	return nil
+}
+
+// debugTimeScale changes the game's time scale with the debug hotkeys (see
+// DebugKeys).
+func (s *GameplayScene) debugTimeScale() {
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyBracketLeft):
+		s.gameData.SetTimeScale(s.gameData.ScaledTime(0.5))
+	case inpututil.IsKeyJustPressed(ebiten.KeyBracketRight):
+		s.gameData.SetTimeScale(s.gameData.ScaledTime(2))
+	case inpututil.IsKeyJustPressed(ebiten.KeyBackslash):
+		s.gameData.SetTimeScale(1)
+	}
+}
+
+// readInput steers the player (or every player in a multiplayer round) and
+// returns the action pressed this frame. During a replay the recorded
+// inputs do the steering (see stepGame) and only the action is used.
+func (s *GameplayScene) readInput() input.Action {
+	if s.playback != nil {
+		_, action := s.inputMgr.Update()
+		return action
+	}
+	if n := len(s.gameData.Players); n > 1 {
+		dirs, action := s.inputMgr.UpdatePlayers(n)
+		for i, dir := range dirs {
+			if dir != input.DirNone {
+				s.gameData.HandlePlayerInput(i+1, gameDirection(dir))
+			}
+		}
+		for i, held := range s.inputMgr.BoostHeldPlayers(n) {
+			s.gameData.SetPlayerBoost(i+1, held)
+		}
+		return action
+	}
+	dir, action := s.inputMgr.Update()
+	turn := gameDirection(dir)
+	if turn == game.DirNone && HoldToTurn {
+		turn = s.heldTurn()
+	}
+	if turn != game.DirNone {
+		s.gameData.HandleInput(turn)
+	}
+	s.gameData.SetBoost(s.inputMgr.BoostHeld())
+	return action
+}
+
+// heldTurn returns the direction held down as a turn for the player (see
+// HoldToTurn), or DirNone while the snake is already heading that way or
+// the opposite way (which it can't turn into), so replays don't record a
+// turn every frame the key is held.
+func (s *GameplayScene) heldTurn() game.Direction {
+	held := gameDirection(s.inputMgr.CurrentHeldDirection())
+	if held == game.DirNone || len(s.gameData.Players) == 0 {
+		return game.DirNone
+	}
+	hx, hy := held.Delta()
+	dx, dy := s.gameData.Players[0].Direction.Delta()
+	if hx*dx+hy*dy != 0 {
+		return game.DirNone // Same way or reversed
+	}
+	return held
+}
+
+// gameDirection translates a direction read by the input manager into the
+// game's.
+func gameDirection(dir input.Direction) game.Direction {
+	switch dir {
+	case input.DirUp:
+		return game.DirUp
+	case input.DirDown:
+		return game.DirDown
+	case input.DirLeft:
+		return game.DirLeft
+	case input.DirRight:
+		return game.DirRight
+	}
+	return game.DirNone
+}
+
+// emitDeathBurst blows a snake apart: every segment emits particles flying
+// away from the middle of the body and falling under gravity.
+func (s *GameplayScene) emitDeathBurst(body []game.Position, clr color.Color) {
+	if len(body) == 0 {
+		return
+	}
+	var midX, midY float64
+	for _, seg := range body {
+		midX += float64(seg.X)
+		midY += float64(seg.Y)
+	}
+	midX /= float64(len(body))
+	midY /= float64(len(body))
+
+	for _, seg := range body {
+		dx, dy := float64(seg.X)-midX, float64(seg.Y)-midY
+		if dist := math.Hypot(dx, dy); dist > 0 {
+			dx, dy = dx/dist, dy/dist
+		}
+		x, y := render.View.CellCenter(float64(seg.X), float64(seg.Y))
+		s.particleSys.Emit(particle.EmitConfig{
+			X:              x,
+			Y:              y,
+			Count:          8,
+			UseGravity:     true,
+			Color:          clr,
+			BaseVelocityX:  dx * deathBurstSpeed,
+			BaseVelocityY:  dy*deathBurstSpeed - deathBurstSpeed/2, // Kick upwards before gravity takes over
+			VelocitySpread: 90,
+			MinLifetime:    0.4,
+			MaxLifetime:    deathEffectDuration,
+			MinSize:        2,
+			MaxSize:        4,
+		})
+	}
+}
+
+// emitSpawnBurst draws particles inwards onto a newly spawned food item or
+// enemy head, colored by what appeared.
+func (s *GameplayScene) emitSpawnBurst(ev game.GameEvent) {
+	var clr color.Color = enemySpawnColor
+	count := 16
+	if ev.Food != nil {
+		clr = render.FoodColor(ev.Food.Type)
+		count = 10
+	}
+	x, y := render.View.CellCenter(float64(ev.Pos.X), float64(ev.Pos.Y))
+	s.particleSys.Emit(particle.EmitConfig{
+		X:             x,
+		Y:             y,
+		Count:         count,
+		Color:         clr,
+		MinLifetime:   spawnBurstLifetime,
+		MaxLifetime:   spawnBurstLifetime,
+		MinSize:       2,
+		MaxSize:       3,
+		ImplodeRadius: float64(render.View.CellSize) * 1.5,
+	})
+}
+
+// restart resets the round and clears any leftover effects.
+func (s *GameplayScene) restart() {
+	s.startRound()
+	s.particleSys.Particles = s.particleSys.Particles[:0]
+}
+
+// startRound resets the game for a new round (or rewinds the replay) and
+// starts the countdown. Live rounds are recorded from their first input,
+// and single-player ones race the best run when it is shown.
+func (s *GameplayScene) startRound() {
+	s.best = nil
+	if s.playback != nil {
+		s.gameData.Recorder = nil
+		s.playback.Start(s.gameData)
+	} else {
+		s.gameData.Reset()
+		s.gameData.Recorder = game.NewRecorder(s.gameData)
+		if render.ShowBestRun && s.gameData.Config.PlayerCount() == 1 && !s.gameData.Practice() {
+			s.best = newBestRun(s.gameData)
+		}
+	}
+	s.round = stats.Round{}
+	s.flashes = nil
+	s.countdown = CountdownDuration
+}
+
+// saveReplay writes the finished round's replay, and keeps it as the best
+// run too when it is a new best single-player score. Failures are only logged.
+func (s *GameplayScene) saveReplay() {
+	if s.gameData.Recorder == nil {
+		return
+	}
+	s.gameData.Recorder.Finish(s.gameData)
+	replay := s.gameData.Recorder.Replay()
+	if err := storage.SaveJSON(ReplayFile, replay); err != nil {
+		log.Printf("Warning: Failed to save replay: %v", err)
+	}
+	if s.gameData.Config.PlayerCount() == 1 && !s.gameData.Practice() {
+		saveIfBest(replay)
+	}
+	s.gameData.Recorder = nil
+}
+
+// recordStats adds the finished round to the lifetime stats. Replays aren't
+// counted again. Failures are only logged.
+func (s *GameplayScene) recordStats() {
+	if s.playback != nil {
+		return
+	}
+	if _, err := stats.Record(s.gameData, s.round); err != nil {
+		log.Printf("Warning: Failed to record stats: %v", err)
+	}
+}
+
 // Draw renders the gameplay screen.
 func (s *GameplayScene) Draw(screen *ebiten.Image) {
 	// Get the current renderable state from the game logic
 	renderState := s.gameData.GetState()
+	renderState.BestRun = s.best.snake()
+	renderState.Flashes = s.flashes
 	// Get assets from the scene manager
 	assets := s.sceneMgr.GetAssets()
 
+	// While shaking, draw the board offscreen and blit it displaced
+	target := screen
+	dx, dy := s.shake.Offset()
+	shaking := dx != 0 || dy != 0
+	if shaking {
+		if s.frame == nil || s.frame.Bounds() != screen.Bounds() {
+			s.frame = ebiten.NewImage(screen.Bounds().Dx(), screen.Bounds().Dy())
+		}
+		s.frame.Clear()
+		target = s.frame
+	}
+
 	// Use the render package to draw everything, passing assets
-	render.DrawGame(screen, renderState, assets)
+	render.DrawGame(target, renderState, assets)
 
 	// Draw particles on top
-	s.particleSys.Draw(screen)
+	s.particleSys.Draw(target)
 
-	// Draw Pause overlay if paused
-	if s.gameData.IsPaused {
+	if shaking {
+		screen.Fill(color.Black)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(dx, dy)
+		screen.DrawImage(s.frame, op)
+	}
+
+	// Countdown number over the frozen board
+	if s.countdown > 0 {
 		width, height := s.sceneMgr.GetWindowSize()
-		ebitenutil.DebugPrintAt(screen, "PAUSED (Press P/Esc to Resume)", width/2-100, height/2)
+		num := fmt.Sprintf("%d", int(math.Ceil(s.countdown)))
+		render.DrawCentered(screen, num, width/2, height/2-countdownFontSize/2, countdownFontSize, render.TextColor)
 	}
 }
@@ -0,0 +1,85 @@
+package gameplay
+
+import (
+	"errors"
+	"io/fs"
+	"log"
+
+	"snake-game/internal/game"
+	"snake-game/internal/storage"
+)
+
+// BestReplayFile is where the replay of the highest-scoring single-player
+// round is kept, in the config directory (see storage.Path).
+const BestReplayFile = "best_replay.json"
+
+// bestRun plays the best recorded round alongside the live one, in lockstep
+// with its game time, so the player can race their own best. It runs in a
+// game of its own and never touches the live round.
+type bestRun struct {
+	game     *game.Game
+	playback *game.Playback
+}
+
+// loadBestReplay reads the best replay, reporting false when there is none
+// yet (or it can't be read).
+func loadBestReplay() (game.Replay, bool) {
+	var replay game.Replay
+	if err := storage.LoadJSON(BestReplayFile, &replay); err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Printf("Warning: Failed to load best replay: %v", err)
+		}
+		return replay, false
+	}
+	return replay, true
+}
+
+// newBestRun starts the best replay from the beginning. It returns nil when
+// there is no best replay or it was played on a board of another size than
+// live's, since its path wouldn't line up.
+func newBestRun(live *game.Game) *bestRun {
+	replay, ok := loadBestReplay()
+	if !ok {
+		return nil
+	}
+	run := &bestRun{game: game.NewGameWithConfig(replay.Config), playback: game.NewPlayback(replay)}
+	run.playback.Start(run.game)
+	if run.game.Width != live.Width || run.game.Height != live.Height {
+		return nil
+	}
+	return run
+}
+
+// step advances the replay by gameTime seconds of game time, as the live
+// game has just advanced, whatever time scale the replay itself runs at, so
+// the two stay in lockstep.
+func (r *bestRun) step(gameTime float64) error {
+	if r.game.IsOver {
+		return nil
+	}
+	r.playback.Feed(r.game)
+	if err := r.game.Update(gameTime / r.game.ScaledTime(1)); err != nil {
+		return err
+	}
+	r.game.DrainEvents() // Nobody reacts to the replay's events
+	return nil
+}
+
+// snake returns the replayed player, or nil once that run has ended.
+func (r *bestRun) snake() *game.Snake {
+	if r == nil || r.game.IsOver || len(r.game.Players) == 0 {
+		return nil
+	}
+	return r.game.Players[0]
+}
+
+// saveIfBest keeps replay as the best one when it beat the saved best score.
+// Failures are only logged.
+func saveIfBest(replay game.Replay) {
+	if best, ok := loadBestReplay(); ok && best.Score >= replay.Score {
+		return
+	}
+	if err := storage.SaveJSON(BestReplayFile, replay); err != nil {
+		log.Printf("Warning: Failed to save best replay: %v", err)
+	}
+}
@@ -0,0 +1,231 @@
+package mainmenu
+
+import (
+	"fmt"
+	"log"
+	"slices"
+
+	"snake-game/internal/audio"
+	"snake-game/internal/game"
+	"snake-game/internal/input"
+	"snake-game/internal/render"
+	"snake-game/internal/savegame"
+	"snake-game/internal/scene"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// menuItem identifies an entry in the main menu.
+type menuItem int
+
+const (
+	itemContinue menuItem = iota // Only listed while a saved round exists
+	itemStart
+	itemMode
+	itemPlayers
+	itemBoard
+	itemWalls
+	itemStats
+	itemOptions
+	itemQuit
+
+	numMenuItems = 9
+)
+
+// MainMenuScene shows the title and lets the player start or quit.
+type MainMenuScene struct {
+	sceneMgr scene.ManagerInterface
+	inputMgr *input.Manager
+	gameData *game.Game
+	items    []menuItem // Items shown, in order
+	selected menuItem
+}
+
+// NewMainMenuScene creates a new main menu scene instance.
+func NewMainMenuScene() *MainMenuScene {
+	return &MainMenuScene{}
+}
+
+// Load initializes the scene.
+func (s *MainMenuScene) Load(manager scene.ManagerInterface, gameData *game.Game) {
+	log.Println("Loading MainMenu Scene")
+	s.sceneMgr = manager
+	s.inputMgr = manager.GetInputManager()
+	s.gameData = gameData
+	s.listItems()
+	s.selected = s.items[0] // Continue when there is a saved round
+	manager.GetMusic().Play(audio.TrackMenu)
+}
+
+// listItems fills in the items shown: all of them, with Continue left out
+// unless a saved round exists.
+func (s *MainMenuScene) listItems() {
+	s.items = s.items[:0]
+	for item := menuItem(0); item < numMenuItems; item++ {
+		if item != itemContinue || savegame.Exists() {
+			s.items = append(s.items, item)
+		}
+	}
+}
+
+// Unload cleans up the scene.
+func (s *MainMenuScene) Unload() scene.SceneType {
+	log.Println("Unloading MainMenu Scene")
+	return scene.SceneTypeMainMenu
+}
+
+// Update moves the cursor and handles selection.
+func (s *MainMenuScene) Update(manager scene.ManagerInterface) (scene.Transition, error) {
+	dir, action := s.inputMgr.Update()
+
+	switch dir {
+	case input.DirUp:
+		s.move(-1)
+		s.sceneMgr.GetAudio().PlayMenuMove()
+	case input.DirDown:
+		s.move(1)
+		s.sceneMgr.GetAudio().PlayMenuMove()
+	case input.DirLeft:
+		s.adjust(s.selected, -1)
+	case input.DirRight:
+		s.adjust(s.selected, 1)
+	}
+
+	switch action {
+	case input.ActionBack, input.ActionPause: // Esc or back asks to quit too
+		s.sceneMgr.ConfirmQuit(nil)
+	case input.ActionConfirm:
+		switch s.selected {
+		case itemContinue:
+			return s.continueRound(), nil
+		case itemStart:
+			return scene.Transition{FromScene: scene.SceneTypeMainMenu, ToScene: scene.SceneTypeGameplay}, nil
+		case itemMode, itemPlayers, itemBoard, itemWalls:
+			s.adjust(s.selected, 1)
+		case itemStats:
+			return scene.Transition{FromScene: scene.SceneTypeMainMenu, ToScene: scene.SceneTypeStats}, nil
+		case itemOptions:
+			return scene.Transition{FromScene: scene.SceneTypeMainMenu, ToScene: scene.SceneTypeOptions}, nil
+		case itemQuit:
+			log.Println("Quit selected from main menu.")
+			s.sceneMgr.ConfirmQuit(nil)
+		}
+	}
+
+	// No transition requested
+	return scene.Transition{}, nil
+}
+
+// move steps the cursor through the items shown, wrapping around.
+func (s *MainMenuScene) move(step int) {
+	n := len(s.items)
+	i := slices.Index(s.items, s.selected)
+	s.selected = s.items[(i+step+n)%n]
+}
+
+// continueRound resumes the saved round: it becomes the shared game and
+// the save is removed, so a run can only be continued once. A save that
+// can't be loaded is removed too, and the menu stays.
+func (s *MainMenuScene) continueRound() scene.Transition {
+	loaded, err := savegame.Load()
+	if rmErr := savegame.Remove(); rmErr != nil {
+		log.Printf("Warning: Failed to remove saved game: %v", rmErr)
+	}
+	if err != nil {
+		log.Printf("Warning: Failed to load saved game: %v", err)
+		s.listItems()
+		s.selected = s.items[0]
+		return scene.Transition{}
+	}
+	s.sceneMgr.SetGameData(loaded)
+	ebiten.SetWindowSize(s.sceneMgr.GetWindowSize()) // The saved board may be another size
+	return scene.Transition{FromScene: scene.SceneTypeMainMenu, ToScene: scene.SceneTypeGameplay}
+}
+
+// adjust changes the value of an option item (mode, players, board size or walls).
+func (s *MainMenuScene) adjust(item menuItem, step int) {
+	switch item {
+	case itemMode:
+		next := (int(s.gameData.Config.Mode) + step + game.NumGameModes) % game.NumGameModes
+		s.gameData.SetMode(game.GameMode(next))
+	case itemPlayers:
+		next := (s.gameData.Config.PlayerCount()-1+step+game.MaxPlayers)%game.MaxPlayers + 1
+		s.gameData.SetPlayers(next)
+	case itemBoard:
+		s.cycleBoardSize(step)
+	case itemWalls:
+		if s.gameData.Config.Level != nil {
+			return
+		}
+		next := (int(s.gameData.Config.Layout) + step + game.NumObstacleLayouts) % game.NumObstacleLayouts
+		s.gameData.SetLayout(game.ObstacleLayout(next))
+	default:
+		return
+	}
+	s.sceneMgr.GetAudio().PlayMenuMove()
+}
+
+// cycleBoardSize steps through the board size presets and resizes the window
+// to match. Boards loaded from a level file keep their own size.
+func (s *MainMenuScene) cycleBoardSize(step int) {
+	if s.gameData.Config.Level != nil {
+		return
+	}
+	current := game.BoardSizeFor(s.gameData.Width, s.gameData.Height)
+	next := (int(current) + step + game.NumBoardSizes) % game.NumBoardSizes
+	s.gameData.SetBoardSize(game.BoardSize(next).Dimensions())
+	ebiten.SetWindowSize(s.sceneMgr.GetWindowSize())
+}
+
+// label returns the display text for a menu item.
+func (s *MainMenuScene) label(item menuItem) string {
+	switch item {
+	case itemContinue:
+		return "Continue"
+	case itemStart:
+		return "Start Game"
+	case itemMode:
+		return fmt.Sprintf("Mode: < %s >", s.gameData.Config.Mode)
+	case itemPlayers:
+		return fmt.Sprintf("Players: < %d >", s.gameData.Config.PlayerCount())
+	case itemBoard:
+		if s.gameData.Config.Level != nil {
+			return fmt.Sprintf("Board: %s (level)", s.gameData.Config.Level.Name)
+		}
+		size := game.BoardSizeFor(s.gameData.Width, s.gameData.Height)
+		return fmt.Sprintf("Board: < %s %dx%d >", size, s.gameData.Width, s.gameData.Height)
+	case itemWalls:
+		if s.gameData.Config.Level != nil {
+			return "Walls: (level)"
+		}
+		return fmt.Sprintf("Walls: < %s >", s.gameData.Config.Layout)
+	case itemStats:
+		return "Statistics"
+	case itemOptions:
+		return "Options"
+	case itemQuit:
+		return "Quit"
+	}
+	return ""
+}
+
+// Draw renders the title and menu items with a cursor next to the active one.
+func (s *MainMenuScene) Draw(screen *ebiten.Image) {
+	width, height := s.sceneMgr.GetWindowSize()
+	screen.Fill(render.CurrentTheme().Background) // Matches the gameplay background
+
+	title := "SUPER SNAKE GO"
+	render.DrawCentered(screen, title, width/2, height/2-130, render.TitleFontSize, render.TextColor)
+
+	for i, item := range s.items {
+		line := s.label(item)
+		if item == s.selected {
+			line = "> " + line + " <"
+		}
+		y := height/2 - 66 + i*21
+		render.DrawCentered(screen, line, width/2, y, render.BodyFontSize, render.TextColor)
+	}
+
+	hint := "Up/Down to choose, Left/Right to change, Enter to select, Esc to quit"
+	render.DrawCentered(screen, hint, width/2, height/2+130, render.BodyFontSize, render.TextColor)
+}
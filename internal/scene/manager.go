@@ -2,46 +2,58 @@ package scene
 
 import (
 	"fmt"
+	"image/color"
 	"log"
 
 	"snake-game/internal/assets" // Import assets package
+	"snake-game/internal/audio"  // Sound effects
 	"snake-game/internal/game"   // Import our core game logic
 	"snake-game/internal/input"  // Import the input package
+	"snake-game/internal/render" // For converting board cells to pixels and the theme
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 	// "snake-game/internal/scene/gameplay" // Remove this import
 	// "snake-game/internal/scene/mainmenu"
 )
 
+// DefaultFadeDuration is the length (seconds) of a full scene transition:
+// half fading the old scene out to black, half fading the new one in.
+const DefaultFadeDuration = 0.4
+
 // Manager handles scene transitions and holds the current scene.
 type Manager struct {
+	// FadeDuration is the length of an animated transition in seconds;
+	// zero swaps scenes instantly.
+	FadeDuration float64
+
 	current           Scene
 	nextScene         Scene // Scene to transition to
 	transition        *Transition
-	screenWidth       int
-	screenHeight      int
+	fadeTime          float64                        // Seconds into the running transition
+	fadeLength        float64                        // Length of the running transition (0 = instant)
+	swapped           bool                           // The running transition has reached its midpoint and loaded nextScene
 	gameData          *game.Game                     // Shared game state data
 	inputManager      *input.Manager                 // Add input manager instance
 	assetManager      *assets.Manager                // Add asset manager instance
+	audioManager      *audio.Manager                 // Sound effects on the shared audio context
+	musicPlayer       *audio.MusicPlayer             // Background music loops
 	sceneConstructors map[SceneType]SceneConstructor // Map to store scene constructors
+	canvas            *ebiten.Image                  // Logical-size image the scenes draw on, scaled onto the window
+	quitPrompt        *quitPrompt                    // Open quit confirmation dialog, if any
 	// Add asset managers, input managers etc. here if needed globally
 }
 
 // NewManager creates a new scene manager and loads assets.
-func NewManager(screenWidth, screenHeight int) *Manager {
-	// Load assets first
-	assetMgr, err := assets.NewManager()
-	if err != nil {
-		log.Fatalf("Failed to initialize asset manager: %v", err)
-	}
-
+// gameCfg sets the rules (and board size) for the shared game state.
+func NewManager(gameCfg game.Config) *Manager {
 	m := &Manager{
-		screenWidth:       screenWidth,
-		screenHeight:      screenHeight,
-		gameData:          game.NewGame(),     // Initialize the core game data
-		inputManager:      input.NewManager(), // Initialize the input manager
-		assetManager:      assetMgr,           // Store the loaded assets
+		FadeDuration:      DefaultFadeDuration,
+		gameData:          game.NewGameWithConfig(gameCfg), // Initialize the core game data
+		inputManager:      input.NewManager(),              // Initialize the input manager
+		assetManager:      assets.NewManager(),             // Missing sprites are replaced or skipped, never fatal
+		audioManager:      audio.NewManager(),              // Missing sounds are skipped, never fatal
+		musicPlayer:       audio.NewMusicPlayer(),          // Restores the saved volume
 		sceneConstructors: make(map[SceneType]SceneConstructor),
 	}
 	// Scenes must be registered before being used.
@@ -77,22 +89,25 @@ func (m *Manager) SetInitialScene(sceneType SceneType) {
 	log.Printf("Set initial scene to %v", sceneType)
 }
 
-// Update updates the current scene and handles transitions.
+// Update updates the current scene and handles transitions. While a
+// transition animates, no scene is updated, so input is ignored until the
+// new scene has fully faded in; the same goes while the quit dialog is open.
 func (m *Manager) Update() error {
 	if m.transition != nil {
-		// Unload old scene
-		if m.current != nil {
-			m.current.Unload()
+		m.fadeTime += 1.0 / float64(ebiten.TPS())
+		half := m.fadeLength / 2
+		if !m.swapped && m.fadeTime >= half {
+			m.swapScenes()
 		}
-		// Set and load new scene
-		m.current = m.nextScene
-		if m.current != nil {
-			m.current.Load(m, m.gameData)
+		if m.fadeTime < m.fadeLength {
+			return nil
 		}
-		// Reset transition state
-		m.nextScene = nil
+		// Transition finished
 		m.transition = nil
 	}
+	if m.quitPrompt != nil {
+		return m.updateQuitPrompt() // The dialog takes the input; the scene waits
+	}
 
 	if m.current != nil {
 		transitionReq, err := m.current.Update(m)
@@ -106,19 +121,89 @@ func (m *Manager) Update() error {
 	return nil
 }
 
-// Draw draws the current scene.
+// swapScenes unloads the current scene and loads the one being transitioned to.
+func (m *Manager) swapScenes() {
+	// Unload old scene
+	if m.current != nil {
+		m.current.Unload()
+	}
+	// Set and load new scene
+	m.current = m.nextScene
+	if m.current != nil {
+		m.current.Load(m, m.gameData)
+	}
+	m.nextScene = nil
+	m.swapped = true
+}
+
+// Draw draws the current scene, darkened while a transition is fading. The
+// scene is drawn at the logical size and then scaled up to fit the window
+// (see fitCanvas), with the margins filled with the background color.
 func (m *Manager) Draw(screen *ebiten.Image) {
+	width, height := m.GetWindowSize()
+	if m.canvas == nil || m.canvas.Bounds().Dx() != width || m.canvas.Bounds().Dy() != height {
+		if m.canvas != nil {
+			m.canvas.Deallocate()
+		}
+		m.canvas = ebiten.NewImage(width, height)
+	}
+	m.canvas.Clear()
+
 	if m.current != nil {
-		m.current.Draw(screen)
+		m.current.Draw(m.canvas)
+	}
+	if m.quitPrompt != nil {
+		drawQuitPrompt(m.canvas, width, height)
+	}
+	if alpha := m.fadeAlpha(); alpha > 0 {
+		black := color.RGBA{A: uint8(alpha * 255)}
+		vector.DrawFilledRect(m.canvas, 0, 0, float32(width), float32(height), black, false)
 	}
+
+	screen.Fill(render.CurrentTheme().Background) // Letterbox around a board whose shape differs from the window's
+	bounds := screen.Bounds()
+	scale, offsetX, offsetY := fitCanvas(width, height, bounds.Dx(), bounds.Dy())
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(offsetX, offsetY)
+	if scale < 1 {
+		op.Filter = ebiten.FilterLinear // Shrinking: nearest would drop whole rows of pixels
+	}
+	screen.DrawImage(m.canvas, op)
+}
+
+// fitCanvas returns the scale and offset that center a width x height canvas
+// in a screenW x screenH window. The scale is the largest whole number that
+// fits, so pixels stay square and sharp; only a window smaller than the canvas
+// gets a fractional (shrinking) scale.
+func fitCanvas(width, height, screenW, screenH int) (scale, offsetX, offsetY float64) {
+	scale = float64(min(screenW/width, screenH/height))
+	if scale < 1 {
+		scale = min(float64(screenW)/float64(width), float64(screenH)/float64(height))
+	}
+	offsetX = (float64(screenW) - float64(width)*scale) / 2
+	offsetY = (float64(screenH) - float64(height)*scale) / 2
+	return scale, offsetX, offsetY
+}
+
+// fadeAlpha returns how dark the fade overlay is (0 clear .. 1 black): it
+// rises to black at the midpoint of a transition and falls back afterwards.
+func (m *Manager) fadeAlpha() float64 {
+	if m.transition == nil || m.fadeLength <= 0 {
+		return 0
+	}
+	half := m.fadeLength / 2
+	if m.fadeTime < half {
+		return m.fadeTime / half
+	}
+	return max(0, (m.fadeLength-m.fadeTime)/half)
 }
 
 // Layout is required by ebiten.Game interface.
+// The screen matches the window so Draw can letterbox the board itself; the
+// logical size the scenes draw at is GetWindowSize.
 func (m *Manager) Layout(outsideWidth, outsideHeight int) (int, int) {
-	// Store the actual window size if needed, but return configured logical size
-	// m.screenWidth = outsideWidth
-	// m.screenHeight = outsideHeight
-	return m.screenWidth, m.screenHeight
+	return max(outsideWidth, 1), max(outsideHeight, 1)
 }
 
 // GoTo initiates a scene transition.
@@ -137,13 +222,27 @@ func (m *Manager) GoTo(transition Transition) {
 	log.Printf("Transition requested from %v to %v", transition.FromScene, transition.ToScene)
 	m.transition = &transition
 	m.nextScene = constructor() // Use the constructor to create the scene instance
+	m.fadeTime = 0
+	m.fadeLength = m.FadeDuration
+	m.swapped = false
+	if transition.FromScene == SceneTypePause || transition.ToScene == SceneTypePause {
+		// The pause menu overlays the frozen board; fading would only flicker
+		m.fadeLength = 0
+	}
 
 	// Removed the old switch statement that directly instantiated scenes
 }
 
-// GetWindowSize returns the logical screen dimensions.
+// GetWindowSize returns the logical screen dimensions, derived from the board size.
 func (m *Manager) GetWindowSize() (int, int) {
-	return m.screenWidth, m.screenHeight
+	return m.gameData.Width * render.View.CellSize, m.gameData.Height * render.View.CellSize
+}
+
+// SetGameData replaces the shared game state, e.g. with a saved round being
+// continued. Scenes loaded from then on get g; the current scene keeps the
+// game it was loaded with.
+func (m *Manager) SetGameData(g *game.Game) {
+	m.gameData = g
 }
 
 // GetInputManager returns the shared input manager.
@@ -157,6 +256,16 @@ func (m *Manager) GetAssets() *assets.Manager {
 	return m.assetManager
 }
 
+// GetAudio returns the shared sound effect manager.
+func (m *Manager) GetAudio() *audio.Manager {
+	return m.audioManager
+}
+
+// GetMusic returns the shared background music player.
+func (m *Manager) GetMusic() *audio.MusicPlayer {
+	return m.musicPlayer
+}
+
 // --- Placeholder Scene --- (Keep for GameOver/Pause for now)
 
 type PlaceholderScene struct {
@@ -175,7 +284,8 @@ func (s *PlaceholderScene) Update(manager ManagerInterface) (Transition, error)
 func (s *PlaceholderScene) Draw(screen *ebiten.Image) {
 	// Simple placeholder drawing
 	msg := fmt.Sprintf("Placeholder Scene: %v", s.sceneType)
-	ebitenutil.DebugPrintAt(screen, msg, 10, 10)
+	bounds := screen.Bounds()
+	render.DrawCentered(screen, msg, bounds.Dx()/2, bounds.Dy()/2, render.BodyFontSize, render.TextColor)
 }
 
 func (s *PlaceholderScene) Load(manager ManagerInterface, gameData *game.Game) {
@@ -0,0 +1,232 @@
+package options
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"slices"
+
+	"snake-game/internal/game"
+	"snake-game/internal/input"
+	"snake-game/internal/render"
+	"snake-game/internal/scene"
+	"snake-game/internal/settings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// menuItem identifies an entry in the options menu.
+type menuItem int
+
+const (
+	itemGrid menuItem = iota
+	itemMinimap
+	itemBestRun
+	itemHighlight
+	itemIntent
+	itemDangerGlow
+	itemSound
+	itemMusic
+	itemDifficulty
+	itemTheme
+	itemZoom
+	itemBack
+
+	numMenuItems = 12
+)
+
+// volumeStep is how much one Left/Right press changes the music volume.
+const volumeStep = 0.1
+
+// OptionsScene lets the player change and save their settings.
+type OptionsScene struct {
+	sceneMgr scene.ManagerInterface
+	inputMgr *input.Manager
+	gameData *game.Game
+	selected menuItem
+}
+
+// NewOptionsScene creates a new options scene instance.
+func NewOptionsScene() *OptionsScene {
+	return &OptionsScene{}
+}
+
+// Load initializes the scene.
+func (s *OptionsScene) Load(manager scene.ManagerInterface, gameData *game.Game) {
+	log.Println("Loading Options Scene")
+	s.sceneMgr = manager
+	s.inputMgr = manager.GetInputManager()
+	s.gameData = gameData
+	s.selected = itemGrid
+}
+
+// Unload cleans up the scene.
+func (s *OptionsScene) Unload() scene.SceneType {
+	log.Println("Unloading Options Scene")
+	return scene.SceneTypeOptions
+}
+
+// Update moves the cursor, changes values with Left/Right and returns to
+// the main menu on Back.
+func (s *OptionsScene) Update(manager scene.ManagerInterface) (scene.Transition, error) {
+	dir, action := s.inputMgr.Update()
+
+	switch dir {
+	case input.DirUp:
+		s.selected = (s.selected + numMenuItems - 1) % numMenuItems
+		s.sceneMgr.GetAudio().PlayMenuMove()
+	case input.DirDown:
+		s.selected = (s.selected + 1) % numMenuItems
+		s.sceneMgr.GetAudio().PlayMenuMove()
+	case input.DirLeft:
+		s.adjust(s.selected, -1)
+	case input.DirRight:
+		s.adjust(s.selected, 1)
+	}
+
+	switch action {
+	case input.ActionConfirm:
+		if s.selected == itemBack {
+			return s.back(), nil
+		}
+		s.adjust(s.selected, 1)
+	case input.ActionBack, input.ActionPause:
+		return s.back(), nil
+	}
+
+	// No transition requested
+	return scene.Transition{}, nil
+}
+
+// back returns to the main menu.
+func (s *OptionsScene) back() scene.Transition {
+	return scene.Transition{FromScene: scene.SceneTypeOptions, ToScene: scene.SceneTypeMainMenu}
+}
+
+// adjust changes the value of an option and saves the settings.
+func (s *OptionsScene) adjust(item menuItem, step int) {
+	switch item {
+	case itemGrid:
+		render.ShowGrid = !render.ShowGrid
+	case itemMinimap:
+		render.ShowMinimap = !render.ShowMinimap
+	case itemBestRun:
+		render.ShowBestRun = !render.ShowBestRun
+	case itemHighlight:
+		render.HighlightFood = !render.HighlightFood
+	case itemIntent:
+		render.ShowIntent = !render.ShowIntent
+	case itemDangerGlow:
+		render.ShowDangerGlow = !render.ShowDangerGlow
+	case itemSound:
+		sounds := s.sceneMgr.GetAudio()
+		sounds.SetEnabled(!sounds.Enabled())
+	case itemMusic:
+		music := s.sceneMgr.GetMusic()
+		music.SetVolume(math.Round((music.Volume()+float64(step)*volumeStep)*10) / 10) // Saved by the music player
+		s.sceneMgr.GetAudio().PlayMenuMove()
+		return
+	case itemDifficulty:
+		next := (int(s.gameData.Config.Difficulty.Level) + step + game.NumDifficultyLevels) % game.NumDifficultyLevels
+		s.gameData.SetDifficulty(game.DifficultyLevel(next))
+	case itemTheme:
+		render.ActiveTheme = (render.ActiveTheme + step + len(render.Themes)) % len(render.Themes)
+	case itemZoom:
+		render.View.CellSize = nextCellSize(render.View.CellSize, step)
+		ebiten.SetWindowSize(s.sceneMgr.GetWindowSize()) // The board takes more or less room
+	default:
+		return
+	}
+	s.sceneMgr.GetAudio().PlayMenuMove()
+	s.save()
+}
+
+// save writes the current settings to disk.
+func (s *OptionsScene) save() {
+	current := settings.Settings{
+		ShowGrid:       render.ShowGrid,
+		ShowMinimap:    render.ShowMinimap,
+		ShowBestRun:    render.ShowBestRun,
+		HighlightFood:  render.HighlightFood,
+		ShowIntent:     render.ShowIntent,
+		ShowDangerGlow: render.ShowDangerGlow,
+		SoundEnabled:   s.sceneMgr.GetAudio().Enabled(),
+		Difficulty:     s.gameData.Config.Difficulty.Level,
+		Theme:          render.ActiveTheme,
+		CellSize:       render.View.CellSize,
+	}
+	if err := settings.Save(current); err != nil {
+		log.Printf("Warning: Failed to save settings: %v", err)
+	}
+}
+
+// label returns the display text for an options item.
+func (s *OptionsScene) label(item menuItem) string {
+	switch item {
+	case itemGrid:
+		return fmt.Sprintf("Grid Lines: < %s >", onOff(render.ShowGrid))
+	case itemMinimap:
+		return fmt.Sprintf("Minimap: < %s >", onOff(render.ShowMinimap))
+	case itemBestRun:
+		return fmt.Sprintf("Best Run Ghost: < %s >", onOff(render.ShowBestRun))
+	case itemHighlight:
+		return fmt.Sprintf("Highlight Nearest Food: < %s >", onOff(render.HighlightFood))
+	case itemIntent:
+		return fmt.Sprintf("Enemy Intent: < %s >", onOff(render.ShowIntent))
+	case itemDangerGlow:
+		return fmt.Sprintf("Danger Glow: < %s >", onOff(render.ShowDangerGlow))
+	case itemSound:
+		return fmt.Sprintf("Sound: < %s >", onOff(s.sceneMgr.GetAudio().Enabled()))
+	case itemMusic:
+		return fmt.Sprintf("Music: < %d%% >", int(math.Round(s.sceneMgr.GetMusic().Volume()*100)))
+	case itemDifficulty:
+		return fmt.Sprintf("Difficulty: < %s >", s.gameData.Config.Difficulty.Level)
+	case itemTheme:
+		return fmt.Sprintf("Theme: < %s >", render.CurrentTheme().Name)
+	case itemZoom:
+		return fmt.Sprintf("Zoom: < %d px >", render.View.CellSize)
+	case itemBack:
+		return "Back"
+	}
+	return ""
+}
+
+// nextCellSize returns the zoom level step places from size in
+// render.CellSizes, wrapping around. An unknown size moves from the default.
+func nextCellSize(size, step int) int {
+	i := slices.Index(render.CellSizes, size)
+	if i < 0 {
+		i = slices.Index(render.CellSizes, render.DefaultCellSize)
+	}
+	n := len(render.CellSizes)
+	return render.CellSizes[((i+step)%n+n)%n]
+}
+
+// onOff formats a toggle for display.
+func onOff(on bool) string {
+	if on {
+		return "On"
+	}
+	return "Off"
+}
+
+// Draw renders the title and options with a cursor next to the active one.
+func (s *OptionsScene) Draw(screen *ebiten.Image) {
+	width, height := s.sceneMgr.GetWindowSize()
+	screen.Fill(render.CurrentTheme().Background) // Shows the chosen theme straight away
+
+	title := "OPTIONS"
+	render.DrawCentered(screen, title, width/2, height/2-130, render.TitleFontSize, render.TextColor)
+
+	for item := menuItem(0); item < numMenuItems; item++ {
+		line := s.label(item)
+		if item == s.selected {
+			line = "> " + line + " <"
+		}
+		y := height/2 - 90 + int(item)*18
+		render.DrawCentered(screen, line, width/2, y, render.BodyFontSize, render.TextColor)
+	}
+
+	hint := "Up/Down to choose, Left/Right to change, Q/Backspace to go back"
+	render.DrawCentered(screen, hint, width/2, height/2+130, render.BodyFontSize, render.TextColor)
+}
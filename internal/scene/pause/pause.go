@@ -0,0 +1,148 @@
+package pause
+
+import (
+	"image/color"
+	"log"
+
+	"snake-game/internal/game"
+	"snake-game/internal/input"
+	"snake-game/internal/render"
+	"snake-game/internal/savegame"
+	"snake-game/internal/scene"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// menuItem identifies an entry in the pause menu.
+type menuItem int
+
+const (
+	itemResume menuItem = iota
+	itemRestart
+	itemQuit
+	itemExit
+)
+
+// menuLabels holds the display text for each menu item, in order.
+var menuLabels = []string{
+	itemResume:  "Resume",
+	itemRestart: "Restart",
+	itemQuit:    "Save and Quit to Menu",
+	itemExit:    "Save and Exit Game",
+}
+
+var overlayColor = color.RGBA{R: 0, G: 0, B: 0, A: 160}
+
+// PauseScene shows the frozen board with a Resume/Restart/Quit/Exit menu on
+// top.
+type PauseScene struct {
+	sceneMgr scene.ManagerInterface
+	inputMgr *input.Manager
+	gameData *game.Game
+	selected menuItem
+}
+
+// NewPauseScene creates a new pause scene instance.
+func NewPauseScene() *PauseScene {
+	return &PauseScene{}
+}
+
+// Load initializes the scene. The game state is left untouched (no Reset)
+// so that resuming continues the same round.
+func (s *PauseScene) Load(manager scene.ManagerInterface, gameData *game.Game) {
+	log.Println("Loading Pause Scene")
+	s.sceneMgr = manager
+	s.inputMgr = manager.GetInputManager()
+	s.gameData = gameData
+	s.selected = itemResume
+	manager.GetMusic().Duck(true)
+}
+
+// Unload cleans up the scene.
+func (s *PauseScene) Unload() scene.SceneType {
+	log.Println("Unloading Pause Scene")
+	s.sceneMgr.GetMusic().Duck(false)
+	return scene.SceneTypePause
+}
+
+// Update handles menu navigation and selection.
+func (s *PauseScene) Update(manager scene.ManagerInterface) (scene.Transition, error) {
+	dir, action := s.inputMgr.Update()
+
+	switch dir {
+	case input.DirUp:
+		s.selected = (s.selected + menuItem(len(menuLabels)) - 1) % menuItem(len(menuLabels))
+		s.sceneMgr.GetAudio().PlayMenuMove()
+	case input.DirDown:
+		s.selected = (s.selected + 1) % menuItem(len(menuLabels))
+		s.sceneMgr.GetAudio().PlayMenuMove()
+	}
+
+	switch action {
+	case input.ActionPause: // P/Esc toggles straight back into the game
+		return s.choose(itemResume), nil
+	case input.ActionRestart:
+		return s.choose(itemRestart), nil
+	case input.ActionBack:
+		return s.choose(itemQuit), nil
+	case input.ActionConfirm:
+		return s.choose(s.selected), nil
+	}
+
+	// No transition requested
+	return scene.Transition{}, nil
+}
+
+// choose performs a menu item's action and returns the resulting transition.
+// Gameplay resumes a game that is still paused and starts a fresh round
+// otherwise, so everything except Resume clears the paused flag first.
+func (s *PauseScene) choose(item menuItem) scene.Transition {
+	switch item {
+	case itemRestart:
+		s.gameData.TogglePause()
+		return scene.Transition{FromScene: scene.SceneTypePause, ToScene: scene.SceneTypeGameplay}
+	case itemQuit:
+		s.saveRound()
+		s.gameData.TogglePause()
+		return scene.Transition{FromScene: scene.SceneTypePause, ToScene: scene.SceneTypeMainMenu}
+	case itemExit:
+		// Saved only once quitting is confirmed; on cancel the menu stays up
+		s.sceneMgr.ConfirmQuit(s.saveRound)
+		return scene.Transition{}
+	default:
+		return scene.Transition{FromScene: scene.SceneTypePause, ToScene: scene.SceneTypeGameplay}
+	}
+}
+
+// saveRound saves the paused round so it can be continued from the main
+// menu. Replays aren't saved. Failures are only logged.
+func (s *PauseScene) saveRound() {
+	if s.gameData.Replaying {
+		return
+	}
+	if err := savegame.Save(s.gameData); err != nil {
+		log.Printf("Warning: Failed to save game: %v", err)
+	}
+}
+
+// Draw renders the frozen board, a dimming overlay and the menu.
+func (s *PauseScene) Draw(screen *ebiten.Image) {
+	width, height := s.sceneMgr.GetWindowSize()
+
+	// Re-render the paused game state underneath the menu
+	render.DrawGame(screen, s.gameData.GetState(), s.sceneMgr.GetAssets())
+	ebitenutil.DrawRect(screen, 0, 0, float64(width), float64(height), overlayColor)
+
+	title := "PAUSED"
+	render.DrawCentered(screen, title, width/2, height/2-70, render.TitleFontSize, render.TextColor)
+
+	for i, label := range menuLabels {
+		line := label
+		if menuItem(i) == s.selected {
+			line = "> " + label + " <"
+		}
+		y := height/2 - 10 + i*24
+		render.DrawCentered(screen, line, width/2, y, render.BodyFontSize, render.TextColor)
+	}
+}
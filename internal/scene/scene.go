@@ -2,6 +2,7 @@ package scene
 
 import (
 	"snake-game/internal/assets" // Import assets
+	"snake-game/internal/audio"  // Import audio
 	"snake-game/internal/game"   // Import our game logic package
 	"snake-game/internal/input"  // Import input package
 
@@ -24,7 +25,8 @@ const (
 	SceneTypeGameplay
 	SceneTypeGameOver
 	SceneTypePause
-	// Add SceneTypeOptions if needed
+	SceneTypeOptions
+	SceneTypeStats
 )
 
 // ManagerInterface defines the methods a scene manager needs.
@@ -34,6 +36,10 @@ type ManagerInterface interface {
 	GetWindowSize() (int, int)
 	GetInputManager() *input.Manager
 	GetAssets() *assets.Manager
+	GetAudio() *audio.Manager
+	GetMusic() *audio.MusicPlayer
+	SetGameData(g *game.Game)  // Replaces the shared game state from the next scene on
+	ConfirmQuit(onQuit func()) // Asks "Quit? Y/N" over the scene; RunGame returns (after onQuit) on yes
 	// Add methods for accessing shared resources like assets if needed
 }
 
@@ -0,0 +1,87 @@
+package statistics
+
+import (
+	"fmt"
+	"log"
+
+	"snake-game/internal/game"
+	"snake-game/internal/input"
+	"snake-game/internal/render"
+	"snake-game/internal/scene"
+	"snake-game/internal/stats"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// StatsScene shows the lifetime statistics.
+type StatsScene struct {
+	sceneMgr scene.ManagerInterface
+	inputMgr *input.Manager
+	totals   stats.Stats
+}
+
+// NewStatsScene creates a new statistics scene instance.
+func NewStatsScene() *StatsScene {
+	return &StatsScene{}
+}
+
+// Load reads the saved stats. On failure the scene shows empty ones.
+func (s *StatsScene) Load(manager scene.ManagerInterface, gameData *game.Game) {
+	log.Println("Loading Stats Scene")
+	s.sceneMgr = manager
+	s.inputMgr = manager.GetInputManager()
+	totals, err := stats.Load()
+	if err != nil {
+		log.Printf("Warning: Failed to load stats: %v", err)
+	}
+	s.totals = totals
+}
+
+// Unload cleans up the scene.
+func (s *StatsScene) Unload() scene.SceneType {
+	log.Println("Unloading Stats Scene")
+	return scene.SceneTypeStats
+}
+
+// Update returns to the main menu on any of the menu keys.
+func (s *StatsScene) Update(manager scene.ManagerInterface) (scene.Transition, error) {
+	_, action := s.inputMgr.Update()
+
+	switch action {
+	case input.ActionConfirm, input.ActionBack, input.ActionPause:
+		return scene.Transition{FromScene: scene.SceneTypeStats, ToScene: scene.SceneTypeMainMenu}, nil
+	}
+
+	// No transition requested
+	return scene.Transition{}, nil
+}
+
+// Draw lists the stats in two aligned columns.
+func (s *StatsScene) Draw(screen *ebiten.Image) {
+	width, height := s.sceneMgr.GetWindowSize()
+	screen.Fill(render.CurrentTheme().Background) // Matches the menus
+
+	title := "STATISTICS"
+	render.DrawCentered(screen, title, width/2, height/2-130, render.TitleFontSize, render.TextColor)
+
+	t := s.totals
+	rows := []struct {
+		label string
+		value string
+	}{
+		{"Rounds played", fmt.Sprint(t.Rounds)},
+		{"Play time", stats.FormatPlayTime(t.PlayTime)},
+		{"Highest score", fmt.Sprint(t.HighestScore)},
+		{"Longest snake", fmt.Sprint(t.LongestSnake)},
+		{"Food eaten", fmt.Sprint(t.FoodEaten)},
+		{"Enemies killed", fmt.Sprint(t.EnemiesKilled)},
+		{"Deaths", fmt.Sprint(t.Deaths)},
+	}
+	for i, row := range rows {
+		line := fmt.Sprintf("%-16s %8s", row.label, row.value)
+		render.DrawCenteredMono(screen, line, width/2, height/2-60+i*22, render.BodyFontSize, render.TextColor)
+	}
+
+	hint := "Q/Backspace to go back"
+	render.DrawCentered(screen, hint, width/2, height/2+130, render.BodyFontSize, render.TextColor)
+}
@@ -0,0 +1,86 @@
+// Package score keeps the persistent top-10 high score table.
+package score
+
+import (
+	"errors"
+	"io/fs"
+	"sort"
+	"time"
+
+	"snake-game/internal/storage"
+)
+
+const (
+	MaxEntries = 10                // Size of the high score table
+	fileName   = "highscores.json" // File in the user config directory
+)
+
+const (
+	MinNameLength = 3  // Fewest characters in a name typed for a high score
+	MaxNameLength = 10 // Most characters in a name typed for a high score
+)
+
+// Entry is a single high score.
+type Entry struct {
+	Score int       `json:"score"`
+	Name  string    `json:"name,omitempty"` // Empty in files from before names were asked for
+	Time  time.Time `json:"time"`
+}
+
+// LoadScores returns the saved high scores, best first.
+// On first run (no file yet) it returns an empty table and no error.
+func LoadScores() ([]Entry, error) {
+	var entries []Entry
+	if err := storage.LoadJSON(fileName, &entries); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []Entry{}, nil
+		}
+		return nil, err
+	}
+	sortEntries(entries)
+	if len(entries) > MaxEntries {
+		entries = entries[:MaxEntries]
+	}
+	return entries, nil
+}
+
+// SaveScore records entry in the high score table if it qualifies.
+func SaveScore(entry Entry) error {
+	entries, err := LoadScores()
+	if err != nil {
+		return err
+	}
+	entries, rank := Insert(entries, entry)
+	if rank < 0 {
+		return nil // Didn't make the table, nothing to write
+	}
+	return storage.SaveJSON(fileName, entries)
+}
+
+// Insert places entry into a sorted table, trimming it to MaxEntries.
+// It returns the new table and the entry's zero-based rank, or -1 if the
+// entry did not qualify. Ties rank below existing entries.
+func Insert(entries []Entry, entry Entry) ([]Entry, int) {
+	rank := sort.Search(len(entries), func(i int) bool { return entries[i].Score < entry.Score })
+	if rank >= MaxEntries {
+		return entries, -1
+	}
+	updated := make([]Entry, 0, len(entries)+1)
+	updated = append(updated, entries[:rank]...)
+	updated = append(updated, entry)
+	updated = append(updated, entries[rank:]...)
+	if len(updated) > MaxEntries {
+		updated = updated[:MaxEntries]
+	}
+	return updated, rank
+}
+
+// sortEntries orders entries best first, oldest first among equal scores.
+func sortEntries(entries []Entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].Time.Before(entries[j].Time)
+	})
+}
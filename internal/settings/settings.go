@@ -0,0 +1,62 @@
+// Package settings persists the player's options (see the options scene)
+// in the user config directory.
+package settings
+
+import (
+	"errors"
+	"io/fs"
+
+	"snake-game/internal/game"
+	"snake-game/internal/storage"
+)
+
+// settingsFile holds the options in the user config directory. The music
+// volume is saved separately by the audio package.
+const settingsFile = "settings.json"
+
+// Settings are the options the player can change from the options scene.
+type Settings struct {
+	ShowGrid       bool                 `json:"show_grid"`        // Draw the grid overlay on the board
+	ShowMinimap    bool                 `json:"show_minimap"`     // Draw the board overview in the bottom-right corner
+	ShowBestRun    bool                 `json:"show_best_run"`    // Replay the best run as a faint snake to race against
+	HighlightFood  bool                 `json:"highlight_food"`   // Outline the food nearest the player
+	ShowIntent     bool                 `json:"show_intent"`      // Mark the cell each enemy moves into next
+	ShowDangerGlow bool                 `json:"show_danger_glow"` // Glow red at the screen edges as enemies close in
+	SoundEnabled   bool                 `json:"sound_enabled"`    // Play sound effects
+	Difficulty     game.DifficultyLevel `json:"difficulty"`       // 0 Easy, 1 Normal, 2 Hard
+	Theme          int                  `json:"theme"`            // Index into render.Themes
+	CellSize       int                  `json:"cell_size"`        // Zoom: pixels per grid cell, one of render.CellSizes; 0 for the default
+}
+
+// Default returns the settings used until the player changes anything.
+func Default() Settings {
+	return Settings{
+		ShowGrid:       false,
+		ShowMinimap:    false,
+		ShowBestRun:    true,
+		ShowDangerGlow: true,
+		SoundEnabled:   true,
+		Difficulty:     game.DifficultyNormal,
+	}
+}
+
+// Load reads the saved settings, falling back to Default when none are
+// saved. On any other error the defaults are returned alongside it.
+func Load() (Settings, error) {
+	s := Default()
+	if err := storage.LoadJSON(settingsFile, &s); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Default(), nil
+		}
+		return Default(), err
+	}
+	if s.Difficulty < 0 || s.Difficulty >= game.NumDifficultyLevels {
+		s.Difficulty = game.DifficultyNormal
+	}
+	return s, nil
+}
+
+// Save writes the settings to disk.
+func Save(s Settings) error {
+	return storage.SaveJSON(settingsFile, s)
+}
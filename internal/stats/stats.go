@@ -0,0 +1,120 @@
+// Package stats keeps lifetime statistics of every round played, across
+// sessions, in the user config directory.
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"snake-game/internal/game"
+	"snake-game/internal/storage"
+)
+
+const (
+	// Version is the schema of the stats file written by this build. Bump it
+	// when the fields change and teach migrate how to bring older files up
+	// to date.
+	Version = 1
+
+	fileName = "stats.json" // File in the user config directory
+)
+
+// Stats are the lifetime totals and records.
+type Stats struct {
+	Version       int     `json:"version"`
+	Rounds        int     `json:"rounds"`         // Rounds played to the end
+	FoodEaten     int     `json:"food_eaten"`     // Food eaten by the players
+	Deaths        int     `json:"deaths"`         // Player deaths
+	EnemiesKilled int     `json:"enemies_killed"` // Enemies that died during the players' rounds
+	LongestSnake  int     `json:"longest_snake"`  // Most segments a player's snake reached
+	HighestScore  int     `json:"highest_score"`  // Best single score, multiplayer rounds included
+	PlayTime      float64 `json:"play_time"`      // Seconds of game time played
+}
+
+// Round tallies one round as it is played. Feed it the game's events with
+// Add and hand it to Record once the round is over.
+type Round struct {
+	FoodEaten     int
+	Deaths        int
+	EnemiesKilled int
+	Longest       int
+}
+
+// Add counts one game event towards the round.
+func (r *Round) Add(ev game.GameEvent) {
+	switch ev.Type {
+	case game.EventFoodEaten:
+		if ev.Snake.IsPlayer {
+			r.FoodEaten++
+			r.Longest = max(r.Longest, len(ev.Snake.Body))
+		}
+	case game.EventEnemyDied:
+		r.EnemiesKilled++
+	case game.EventPlayerDied:
+		r.Deaths++
+	}
+}
+
+// Load returns the saved stats, brought up to the current Version. On
+// first run (no file yet) it returns empty stats and no error. A file from a
+// newer build is reported as an error rather than read, so it isn't
+// overwritten with fewer fields.
+func Load() (Stats, error) {
+	var s Stats
+	if err := storage.LoadJSON(fileName, &s); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Stats{Version: Version}, nil
+		}
+		return Stats{Version: Version}, err
+	}
+	if s.Version > Version {
+		return Stats{Version: Version}, fmt.Errorf("%s has version %d, newer than %d", fileName, s.Version, Version)
+	}
+	migrate(&s)
+	return s, nil
+}
+
+// migrate updates stats read from an older file to the current Version.
+func migrate(s *Stats) {
+	switch s.Version {
+	case 0:
+		// Files written before the version field have the version 1 fields
+		fallthrough
+	default:
+		s.Version = Version
+	}
+}
+
+// Record adds a finished round of g, tallied in round, to the saved stats
+// and returns the updated totals. Nothing is saved if the stats couldn't be
+// loaded, so a damaged or newer file isn't replaced.
+func Record(g *game.Game, round Round) (Stats, error) {
+	s, err := Load()
+	if err != nil {
+		return s, err
+	}
+	s.Rounds++
+	s.FoodEaten += round.FoodEaten
+	s.Deaths += round.Deaths
+	s.EnemiesKilled += round.EnemiesKilled
+	s.LongestSnake = max(s.LongestSnake, round.Longest)
+	for _, player := range g.Players {
+		s.LongestSnake = max(s.LongestSnake, len(player.Body))
+	}
+	for _, score := range g.Scores {
+		s.HighestScore = max(s.HighestScore, score)
+	}
+	s.PlayTime += g.GameTime
+	return s, storage.SaveJSON(fileName, s)
+}
+
+// FormatPlayTime renders seconds of play as e.g. "1h 05m" or "12m 30s".
+func FormatPlayTime(seconds float64) string {
+	total := int(seconds)
+	h, m, sec := total/3600, total/60%60, total%60
+	if h > 0 {
+		return fmt.Sprintf("%dh %02dm", h, m)
+	}
+	return fmt.Sprintf("%dm %02ds", m, sec)
+}